@@ -165,6 +165,7 @@ variable "service_labels" {
     "ram",
     "rds",
     "redshift",
+    "redshiftdata",
     "resourcegroups",
     "resourcegroupstaggingapi",
     "robomaker",
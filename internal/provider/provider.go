@@ -101,6 +101,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
@@ -116,6 +117,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
@@ -365,6 +367,7 @@ func Provider() *schema.Provider {
 
 			"aws_batch_compute_environment": batch.DataSourceComputeEnvironment(),
 			"aws_batch_job_queue":           batch.DataSourceJobQueue(),
+			"aws_batch_scheduling_policy":   batch.DataSourceSchedulingPolicy(),
 
 			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
 
@@ -427,6 +430,7 @@ func Provider() *schema.Provider {
 			"aws_ebs_snapshot_ids":                           ec2.DataSourceEBSSnapshotIDs(),
 			"aws_ebs_volume":                                 ec2.DataSourceEBSVolume(),
 			"aws_ebs_volumes":                                ec2.DataSourceEBSVolumes(),
+			"aws_ec2_account_attributes":                     ec2.DataSourceAccountAttributes(),
 			"aws_ec2_coip_pool":                              ec2.DataSourceCoIPPool(),
 			"aws_ec2_coip_pools":                             ec2.DataSourceCoIPPools(),
 			"aws_ec2_host":                                   ec2.DataSourceHost(),
@@ -442,6 +446,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_local_gateway":                          ec2.DataSourceLocalGateway(),
 			"aws_ec2_local_gateways":                         ec2.DataSourceLocalGateways(),
 			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
+			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
 			"aws_ec2_transit_gateway_dx_gateway_attachment":  ec2.DataSourceTransitGatewayDxGatewayAttachment(),
@@ -481,6 +486,7 @@ func Provider() *schema.Provider {
 
 			"aws_ecr_authorization_token": ecr.DataSourceAuthorizationToken(),
 			"aws_ecr_image":               ecr.DataSourceImage(),
+			"aws_ecr_image_scan_findings": ecr.DataSourceImageScanFindings(),
 			"aws_ecr_repository":          ecr.DataSourceRepository(),
 
 			"aws_ecs_cluster":              ecs.DataSourceCluster(),
@@ -493,12 +499,13 @@ func Provider() *schema.Provider {
 			"aws_efs_file_system":   efs.DataSourceFileSystem(),
 			"aws_efs_mount_target":  efs.DataSourceMountTarget(),
 
-			"aws_eks_addon":        eks.DataSourceAddon(),
-			"aws_eks_cluster":      eks.DataSourceCluster(),
-			"aws_eks_clusters":     eks.DataSourceClusters(),
-			"aws_eks_cluster_auth": eks.DataSourceClusterAuth(),
-			"aws_eks_node_group":   eks.DataSourceNodeGroup(),
-			"aws_eks_node_groups":  eks.DataSourceNodeGroups(),
+			"aws_eks_addon":         eks.DataSourceAddon(),
+			"aws_eks_addon_version": eks.DataSourceAddonVersion(),
+			"aws_eks_cluster":       eks.DataSourceCluster(),
+			"aws_eks_clusters":      eks.DataSourceClusters(),
+			"aws_eks_cluster_auth":  eks.DataSourceClusterAuth(),
+			"aws_eks_node_group":    eks.DataSourceNodeGroup(),
+			"aws_eks_node_groups":   eks.DataSourceNodeGroups(),
 
 			"aws_elasticache_cluster":           elasticache.DataSourceCluster(),
 			"aws_elasticache_replication_group": elasticache.DataSourceReplicationGroup(),
@@ -584,6 +591,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_functions":           lambda.DataSourceFunctions(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -798,7 +806,9 @@ func Provider() *schema.Provider {
 			"aws_apprunner_auto_scaling_configuration_version": apprunner.ResourceAutoScalingConfigurationVersion(),
 			"aws_apprunner_connection":                         apprunner.ResourceConnection(),
 			"aws_apprunner_custom_domain_association":          apprunner.ResourceCustomDomainAssociation(),
+			"aws_apprunner_observability_configuration":        apprunner.ResourceObservabilityConfiguration(),
 			"aws_apprunner_service":                            apprunner.ResourceService(),
+			"aws_apprunner_vpc_connector":                      apprunner.ResourceVPCConnector(),
 
 			"aws_appstream_directory_config":        appstream.ResourceDirectoryConfig(),
 			"aws_appstream_fleet":                   appstream.ResourceFleet(),
@@ -841,6 +851,7 @@ func Provider() *schema.Provider {
 			"aws_batch_compute_environment": batch.ResourceComputeEnvironment(),
 			"aws_batch_job_definition":      batch.ResourceJobDefinition(),
 			"aws_batch_job_queue":           batch.ResourceJobQueue(),
+			"aws_batch_scheduling_policy":   batch.ResourceSchedulingPolicy(),
 
 			"aws_budgets_budget":        budgets.ResourceBudget(),
 			"aws_budgets_budget_action": budgets.ResourceBudgetAction(),
@@ -1013,10 +1024,14 @@ func Provider() *schema.Provider {
 			"aws_directory_service_directory":             ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":      ds.ResourceLogSubscription(),
 
+			"aws_dynamodb_backup":                        dynamodb.ResourceBackup(),
+			"aws_dynamodb_contributor_insights":          dynamodb.ResourceContributorInsights(),
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_export":                  dynamodb.ResourceTableExport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_replica":                 dynamodb.ResourceTableReplica(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
 			"aws_ami":                                             ec2.ResourceAMI(),
@@ -1045,10 +1060,13 @@ func Provider() *schema.Provider {
 			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(),
 			"aws_ec2_fleet":                                       ec2.ResourceFleet(),
 			"aws_ec2_host":                                        ec2.ResourceHost(),
+			"aws_ec2_instance_state":                              ec2.ResourceInstanceState(),
 			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
 			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_serial_console_access":                       ec2.ResourceSerialConsoleAccess(),
+			"aws_ec2_subnet_cidr_reservation":                     ec2.ResourceSubnetCIDRReservation(),
 			"aws_ec2_tag":                                         ec2.ResourceTag(),
 			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
 			"aws_ec2_traffic_mirror_filter_rule":                  ec2.ResourceTrafficMirrorFilterRule(),
@@ -1104,6 +1122,7 @@ func Provider() *schema.Provider {
 			"aws_vpc_ipam_pool":                                   ec2.ResourceVPCIpamPool(),
 			"aws_vpc_ipam_pool_cidr_allocation":                   ec2.ResourceVPCIpamPoolCidrAllocation(),
 			"aws_vpc_ipam_pool_cidr":                              ec2.ResourceVPCIpamPoolCidr(),
+			"aws_vpc_ipam_preview_next_cidr":                      ec2.ResourceVPCIpamPreviewNextCidr(),
 			"aws_vpc_ipam_scope":                                  ec2.ResourceVPCIpamScope(),
 			"aws_vpc_ipv4_cidr_block_association":                 ec2.ResourceVPCIPv4CIDRBlockAssociation(),
 			"aws_vpc_ipv6_cidr_block_association":                 ec2.ResourceVPCIPv6CIDRBlockAssociation(),
@@ -1116,20 +1135,25 @@ func Provider() *schema.Provider {
 			"aws_vpn_gateway_attachment":                          ec2.ResourceVPNGatewayAttachment(),
 			"aws_vpn_gateway_route_propagation":                   ec2.ResourceVPNGatewayRoutePropagation(),
 
-			"aws_ecr_lifecycle_policy":          ecr.ResourceLifecyclePolicy(),
-			"aws_ecr_registry_policy":           ecr.ResourceRegistryPolicy(),
-			"aws_ecr_replication_configuration": ecr.ResourceReplicationConfiguration(),
-			"aws_ecr_repository":                ecr.ResourceRepository(),
-			"aws_ecr_repository_policy":         ecr.ResourceRepositoryPolicy(),
+			"aws_ecr_lifecycle_policy":                ecr.ResourceLifecyclePolicy(),
+			"aws_ecr_pull_through_cache_rule":         ecr.ResourcePullThroughCacheRule(),
+			"aws_ecr_registry_policy":                 ecr.ResourceRegistryPolicy(),
+			"aws_ecr_registry_scanning_configuration": ecr.ResourceRegistryScanningConfiguration(),
+			"aws_ecr_replication_configuration":       ecr.ResourceReplicationConfiguration(),
+			"aws_ecr_repository":                      ecr.ResourceRepository(),
+			"aws_ecr_repository_policy":               ecr.ResourceRepositoryPolicy(),
 
 			"aws_ecrpublic_repository": ecrpublic.ResourceRepository(),
 
-			"aws_ecs_account_setting_default": ecs.ResourceAccountSettingDefault(),
-			"aws_ecs_capacity_provider":       ecs.ResourceCapacityProvider(),
-			"aws_ecs_cluster":                 ecs.ResourceCluster(),
-			"aws_ecs_service":                 ecs.ResourceService(),
-			"aws_ecs_tag":                     ecs.ResourceTag(),
-			"aws_ecs_task_definition":         ecs.ResourceTaskDefinition(),
+			"aws_ecs_account_setting_default":    ecs.ResourceAccountSettingDefault(),
+			"aws_ecs_capacity_provider":          ecs.ResourceCapacityProvider(),
+			"aws_ecs_cluster":                    ecs.ResourceCluster(),
+			"aws_ecs_cluster_capacity_providers": ecs.ResourceClusterCapacityProviders(),
+			"aws_ecs_service":                    ecs.ResourceService(),
+			"aws_ecs_tag":                        ecs.ResourceTag(),
+			"aws_ecs_task_definition":            ecs.ResourceTaskDefinition(),
+			"aws_ecs_task_execution":             ecs.ResourceTaskExecution(),
+			"aws_ecs_task_set":                   ecs.ResourceTaskSet(),
 
 			"aws_efs_access_point":       efs.ResourceAccessPoint(),
 			"aws_efs_backup_policy":      efs.ResourceBackupPolicy(),
@@ -1142,6 +1166,7 @@ func Provider() *schema.Provider {
 			"aws_eks_fargate_profile":          eks.ResourceFargateProfile(),
 			"aws_eks_identity_provider_config": eks.ResourceIdentityProviderConfig(),
 			"aws_eks_node_group":               eks.ResourceNodeGroup(),
+			"aws_eks_registered_cluster":       eks.ResourceRegisteredCluster(),
 
 			"aws_elasticache_cluster":                  elasticache.ResourceCluster(),
 			"aws_elasticache_global_replication_group": elasticache.ResourceGlobalReplicationGroup(),
@@ -1361,6 +1386,13 @@ func Provider() *schema.Provider {
 			"aws_media_store_container":        mediastore.ResourceContainer(),
 			"aws_media_store_container_policy": mediastore.ResourceContainerPolicy(),
 
+			"aws_memorydb_acl":             memorydb.ResourceACL(),
+			"aws_memorydb_cluster":         memorydb.ResourceCluster(),
+			"aws_memorydb_parameter_group": memorydb.ResourceParameterGroup(),
+			"aws_memorydb_snapshot":        memorydb.ResourceSnapshot(),
+			"aws_memorydb_subnet_group":    memorydb.ResourceSubnetGroup(),
+			"aws_memorydb_user":            memorydb.ResourceUser(),
+
 			"aws_mq_broker":        mq.ResourceBroker(),
 			"aws_mq_configuration": mq.ResourceConfiguration(),
 
@@ -1429,27 +1461,35 @@ func Provider() *schema.Provider {
 			"aws_ram_resource_share":          ram.ResourceResourceShare(),
 			"aws_ram_resource_share_accepter": ram.ResourceResourceShareAccepter(),
 
-			"aws_db_cluster_snapshot":           rds.ResourceClusterSnapshot(),
-			"aws_db_event_subscription":         rds.ResourceEventSubscription(),
-			"aws_db_instance":                   rds.ResourceInstance(),
-			"aws_db_instance_role_association":  rds.ResourceInstanceRoleAssociation(),
-			"aws_db_option_group":               rds.ResourceOptionGroup(),
-			"aws_db_parameter_group":            rds.ResourceParameterGroup(),
-			"aws_db_proxy":                      rds.ResourceProxy(),
-			"aws_db_proxy_default_target_group": rds.ResourceProxyDefaultTargetGroup(),
-			"aws_db_proxy_endpoint":             rds.ResourceProxyEndpoint(),
-			"aws_db_proxy_target":               rds.ResourceProxyTarget(),
-			"aws_db_security_group":             rds.ResourceSecurityGroup(),
-			"aws_db_snapshot":                   rds.ResourceSnapshot(),
-			"aws_db_subnet_group":               rds.ResourceSubnetGroup(),
-			"aws_rds_cluster":                   rds.ResourceCluster(),
-			"aws_rds_cluster_endpoint":          rds.ResourceClusterEndpoint(),
-			"aws_rds_cluster_instance":          rds.ResourceClusterInstance(),
-			"aws_rds_cluster_parameter_group":   rds.ResourceClusterParameterGroup(),
-			"aws_rds_cluster_role_association":  rds.ResourceClusterRoleAssociation(),
-			"aws_rds_global_cluster":            rds.ResourceGlobalCluster(),
-
+			"aws_db_cluster_snapshot":                       rds.ResourceClusterSnapshot(),
+			"aws_db_event_subscription":                     rds.ResourceEventSubscription(),
+			"aws_db_instance":                               rds.ResourceInstance(),
+			"aws_db_instance_automated_backups_replication": rds.ResourceInstanceAutomatedBackupsReplication(),
+			"aws_db_instance_role_association":              rds.ResourceInstanceRoleAssociation(),
+			"aws_db_option_group":                           rds.ResourceOptionGroup(),
+			"aws_db_parameter_group":                        rds.ResourceParameterGroup(),
+			"aws_db_proxy":                                  rds.ResourceProxy(),
+			"aws_db_proxy_default_target_group":             rds.ResourceProxyDefaultTargetGroup(),
+			"aws_db_proxy_endpoint":                         rds.ResourceProxyEndpoint(),
+			"aws_db_proxy_target":                           rds.ResourceProxyTarget(),
+			"aws_db_security_group":                         rds.ResourceSecurityGroup(),
+			"aws_db_snapshot":                               rds.ResourceSnapshot(),
+			"aws_db_snapshot_copy":                          rds.ResourceSnapshotCopy(),
+			"aws_db_subnet_group":                           rds.ResourceSubnetGroup(),
+			"aws_rds_cluster":                               rds.ResourceCluster(),
+			"aws_rds_cluster_activity_stream":               rds.ResourceClusterActivityStream(),
+			"aws_rds_cluster_endpoint":                      rds.ResourceClusterEndpoint(),
+			"aws_rds_cluster_instance":                      rds.ResourceClusterInstance(),
+			"aws_rds_cluster_parameter_group":               rds.ResourceClusterParameterGroup(),
+			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
+			"aws_rds_custom_db_engine_version":              rds.ResourceCustomDBEngineVersion(),
+			"aws_rds_export_task":                           rds.ResourceExportTask(),
+			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
+
+			"aws_redshift_authentication_profile":        redshift.ResourceAuthenticationProfile(),
 			"aws_redshift_cluster":                       redshift.ResourceCluster(),
+			"aws_redshift_endpoint_access":               redshift.ResourceEndpointAccess(),
+			"aws_redshift_endpoint_authorization":        redshift.ResourceEndpointAuthorization(),
 			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
 			"aws_redshift_parameter_group":               redshift.ResourceParameterGroup(),
 			"aws_redshift_scheduled_action":              redshift.ResourceScheduledAction(),
@@ -1458,6 +1498,9 @@ func Provider() *schema.Provider {
 			"aws_redshift_snapshot_schedule":             redshift.ResourceSnapshotSchedule(),
 			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(),
 			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
+			"aws_redshift_usage_limit":                   redshift.ResourceUsageLimit(),
+
+			"aws_redshiftdata_statement": redshiftdata.ResourceStatement(),
 
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
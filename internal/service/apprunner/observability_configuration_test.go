@@ -0,0 +1,250 @@
+package apprunner_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapprunner "github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
+)
+
+func TestAccAppRunnerObservabilityConfiguration_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_observability_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObservabilityConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerObservabilityConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObservabilityConfigurationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "apprunner", regexp.MustCompile(fmt.Sprintf(`observabilityconfiguration/%s/1/.+`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "latest", "true"),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration_revision", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", apprunner.ObservabilityConfigurationStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "trace_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppRunnerObservabilityConfiguration_traceConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_observability_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObservabilityConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerObservabilityConfigurationConfig_traceConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObservabilityConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "trace_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trace_configuration.0.vendor", apprunner.TracingVendorAwsxray),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppRunnerObservabilityConfiguration_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_observability_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObservabilityConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerObservabilityConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObservabilityConfigurationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfapprunner.ResourceObservabilityConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppRunnerObservabilityConfiguration_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_observability_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObservabilityConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerObservabilityConfigurationConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObservabilityConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppRunnerObservabilityConfigurationConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObservabilityConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAppRunnerObservabilityConfigurationConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObservabilityConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObservabilityConfigurationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apprunner_observability_configuration" {
+			continue
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+
+		input := &apprunner.DescribeObservabilityConfigurationInput{
+			ObservabilityConfigurationArn: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeObservabilityConfigurationWithContext(context.Background(), input)
+
+		if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.ObservabilityConfiguration != nil && aws.StringValue(output.ObservabilityConfiguration.Status) != apprunner.ObservabilityConfigurationStatusInactive {
+			return fmt.Errorf("App Runner Observability Configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckObservabilityConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No App Runner Observability Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+
+		input := &apprunner.DescribeObservabilityConfigurationInput{
+			ObservabilityConfigurationArn: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeObservabilityConfigurationWithContext(context.Background(), input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.ObservabilityConfiguration == nil {
+			return fmt.Errorf("App Runner Observability Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAppRunnerObservabilityConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_observability_configuration" "test" {
+  observability_configuration_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAppRunnerObservabilityConfigurationConfig_traceConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_observability_configuration" "test" {
+  observability_configuration_name = %[1]q
+
+  trace_configuration {
+    vendor = "AWSXRAY"
+  }
+}
+`, rName)
+}
+
+func testAccAppRunnerObservabilityConfigurationConfigTags1(rName string, tagKey1 string, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_observability_configuration" "test" {
+  observability_configuration_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAppRunnerObservabilityConfigurationConfigTags2(rName string, tagKey1 string, tagValue1 string, tagKey2 string, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_observability_configuration" "test" {
+  observability_configuration_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -152,6 +152,56 @@ func ResourceService() *schema.Resource {
 				},
 			},
 
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"egress_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"egress_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      apprunner.EgressTypeDefault,
+										ValidateFunc: validation.StringInSlice(apprunner.EgressType_Values(), false),
+									},
+									"vpc_connector_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"observability_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"observability_configuration_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"observability_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"service_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -379,6 +429,14 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.InstanceConfiguration = expandAppRunnerServiceInstanceConfiguration(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.NetworkConfiguration = expandAppRunnerServiceNetworkConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("observability_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ObservabilityConfiguration = expandAppRunnerServiceObservabilityConfiguration(v.([]interface{}))
+	}
+
 	var output *apprunner.CreateServiceOutput
 
 	err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
@@ -477,6 +535,34 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(fmt.Errorf("error setting instance_configuration: %w", err))
 	}
 
+	networkConfiguration := flattenAppRunnerServiceNetworkConfiguration(service.NetworkConfiguration)
+
+	// App Runner reports the default egress for services that never had a network configuration,
+	// so only keep the default egress without a VPC connector if it is already configured.
+	if v := service.NetworkConfiguration; v != nil && (v.EgressConfiguration == nil || (aws.StringValue(v.EgressConfiguration.EgressType) == apprunner.EgressTypeDefault && aws.StringValue(v.EgressConfiguration.VpcConnectorArn) == "")) {
+		if _, ok := d.GetOk("network_configuration"); !ok {
+			networkConfiguration = nil
+		}
+	}
+
+	if err := d.Set("network_configuration", networkConfiguration); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting network_configuration: %w", err))
+	}
+
+	observabilityConfiguration := flattenAppRunnerServiceObservabilityConfiguration(service.ObservabilityConfiguration)
+
+	// App Runner reports a disabled configuration for services that never had one,
+	// so only keep a disabled configuration without an ARN if it is already configured.
+	if v := service.ObservabilityConfiguration; v != nil && !aws.BoolValue(v.ObservabilityEnabled) && aws.StringValue(v.ObservabilityConfigurationArn) == "" {
+		if _, ok := d.GetOk("observability_configuration"); !ok {
+			observabilityConfiguration = nil
+		}
+	}
+
+	if err := d.Set("observability_configuration", observabilityConfiguration); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting observability_configuration: %w", err))
+	}
+
 	if err := d.Set("source_configuration", flattenAppRunnerServiceSourceConfiguration(service.SourceConfiguration)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting source_configuration: %w", err))
 	}
@@ -507,6 +593,8 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	if d.HasChanges(
 		"auto_scaling_configuration_arn",
 		"instance_configuration",
+		"network_configuration",
+		"observability_configuration",
 		"source_configuration",
 	) {
 		input := &apprunner.UpdateServiceInput{
@@ -521,6 +609,30 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.InstanceConfiguration = expandAppRunnerServiceInstanceConfiguration(d.Get("instance_configuration").([]interface{}))
 		}
 
+		if d.HasChange("network_configuration") {
+			input.NetworkConfiguration = expandAppRunnerServiceNetworkConfiguration(d.Get("network_configuration").([]interface{}))
+
+			// Removing the configuration block reverts the service to the default egress.
+			if input.NetworkConfiguration == nil {
+				input.NetworkConfiguration = &apprunner.NetworkConfiguration{
+					EgressConfiguration: &apprunner.EgressConfiguration{
+						EgressType: aws.String(apprunner.EgressTypeDefault),
+					},
+				}
+			}
+		}
+
+		if d.HasChange("observability_configuration") {
+			input.ObservabilityConfiguration = expandAppRunnerServiceObservabilityConfiguration(d.Get("observability_configuration").([]interface{}))
+
+			// Removing the configuration block disables observability.
+			if input.ObservabilityConfiguration == nil {
+				input.ObservabilityConfiguration = &apprunner.ServiceObservabilityConfiguration{
+					ObservabilityEnabled: aws.Bool(false),
+				}
+			}
+		}
+
 		if d.HasChange("source_configuration") {
 			input.SourceConfiguration = expandAppRunnerServiceSourceConfiguration(d.Get("source_configuration").([]interface{}))
 		}
@@ -663,6 +775,74 @@ func expandAppRunnerServiceInstanceConfiguration(l []interface{}) *apprunner.Ins
 	return result
 }
 
+func expandAppRunnerServiceNetworkConfiguration(l []interface{}) *apprunner.NetworkConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	result := &apprunner.NetworkConfiguration{}
+
+	if v, ok := tfMap["egress_configuration"].([]interface{}); ok && len(v) > 0 {
+		result.EgressConfiguration = expandAppRunnerServiceEgressConfiguration(v)
+	}
+
+	return result
+}
+
+func expandAppRunnerServiceEgressConfiguration(l []interface{}) *apprunner.EgressConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	result := &apprunner.EgressConfiguration{}
+
+	if v, ok := tfMap["egress_type"].(string); ok && v != "" {
+		result.EgressType = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_connector_arn"].(string); ok && v != "" {
+		result.VpcConnectorArn = aws.String(v)
+	}
+
+	return result
+}
+
+func expandAppRunnerServiceObservabilityConfiguration(l []interface{}) *apprunner.ServiceObservabilityConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	result := &apprunner.ServiceObservabilityConfiguration{}
+
+	if v, ok := tfMap["observability_configuration_arn"].(string); ok && v != "" {
+		result.ObservabilityConfigurationArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["observability_enabled"].(bool); ok {
+		result.ObservabilityEnabled = aws.Bool(v)
+	}
+
+	return result
+}
+
 func expandAppRunnerServiceSourceConfiguration(l []interface{}) *apprunner.SourceConfiguration {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -930,6 +1110,44 @@ func flattenAppRunnerServiceInstanceConfiguration(config *apprunner.InstanceConf
 	return []interface{}{m}
 }
 
+func flattenAppRunnerServiceNetworkConfiguration(config *apprunner.NetworkConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"egress_configuration": flattenAppRunnerServiceEgressConfiguration(config.EgressConfiguration),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenAppRunnerServiceEgressConfiguration(config *apprunner.EgressConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"egress_type":       aws.StringValue(config.EgressType),
+		"vpc_connector_arn": aws.StringValue(config.VpcConnectorArn),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenAppRunnerServiceObservabilityConfiguration(config *apprunner.ServiceObservabilityConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"observability_configuration_arn": aws.StringValue(config.ObservabilityConfigurationArn),
+		"observability_enabled":           aws.BoolValue(config.ObservabilityEnabled),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenAppRunnerServiceCodeRepository(r *apprunner.CodeRepository) []interface{} {
 	if r == nil {
 		return []interface{}{}
@@ -302,6 +302,99 @@ func TestAccAppRunnerService_ImageRepository_runtimeEnvironmentVars(t *testing.T
 	})
 }
 
+func TestAccAppRunnerService_ImageRepository_networkConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_service.test"
+	vpcConnectorResourceName := "aws_apprunner_vpc_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerService_imageRepository(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "0"),
+				),
+			},
+			{
+				Config: testAccAppRunnerService_imageRepository_networkConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.egress_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.egress_configuration.0.egress_type", apprunner.EgressTypeVpc),
+					resource.TestCheckResourceAttrPair(resourceName, "network_configuration.0.egress_configuration.0.vpc_connector_arn", vpcConnectorResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppRunnerService_imageRepository_networkConfigurationDefault(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "0"),
+					testAccCheckServiceEgressType(resourceName, apprunner.EgressTypeDefault),
+				),
+			},
+			{
+				Config:   testAccAppRunnerService_imageRepository_networkConfigurationDefault(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAppRunnerService_ImageRepository_observabilityConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_service.test"
+	observabilityConfigurationResourceName := "aws_apprunner_observability_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerService_imageRepository_observabilityConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "observability_configuration.0.observability_configuration_arn", observabilityConfigurationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.0.observability_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppRunnerService_imageRepository_observabilityConfigurationDisabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.0.observability_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAppRunnerService_imageRepository(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAppRunnerService_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_service.test"
@@ -429,6 +522,37 @@ func testAccCheckServiceExists(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckServiceEgressType(n, egressType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+
+		input := &apprunner.DescribeServiceInput{
+			ServiceArn: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeServiceWithContext(context.Background(), input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Service == nil || output.Service.NetworkConfiguration == nil || output.Service.NetworkConfiguration.EgressConfiguration == nil {
+			return fmt.Errorf("App Runner Service (%s) egress configuration not found", rs.Primary.ID)
+		}
+
+		if got := aws.StringValue(output.Service.NetworkConfiguration.EgressConfiguration.EgressType); got != egressType {
+			return fmt.Errorf("App Runner Service (%s) egress type is %q, expected %q", rs.Primary.ID, got, egressType)
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheckAppRunner(t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
 	ctx := context.Background()
@@ -691,6 +815,114 @@ resource "aws_apprunner_service" "test" {
 `, rName))
 }
 
+func testAccAppRunnerService_imageRepository_networkConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccAppRunnerVPCConnectorConfigBase(rName), fmt.Sprintf(`
+resource "aws_apprunner_vpc_connector" "test" {
+  vpc_connector_name = %[1]q
+  subnets            = aws_subnet.test[*].id
+  security_groups    = [aws_security_group.test.id]
+}
+
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+
+  network_configuration {
+    egress_configuration {
+      egress_type       = "VPC"
+      vpc_connector_arn = aws_apprunner_vpc_connector.test.arn
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAppRunnerService_imageRepository_networkConfigurationDefault(rName string) string {
+	return acctest.ConfigCompose(testAccAppRunnerVPCConnectorConfigBase(rName), fmt.Sprintf(`
+resource "aws_apprunner_vpc_connector" "test" {
+  vpc_connector_name = %[1]q
+  subnets            = aws_subnet.test[*].id
+  security_groups    = [aws_security_group.test.id]
+}
+
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAppRunnerService_imageRepository_observabilityConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_observability_configuration" "test" {
+  observability_configuration_name = %[1]q
+
+  trace_configuration {
+    vendor = "AWSXRAY"
+  }
+}
+
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+
+  observability_configuration {
+    observability_configuration_arn = aws_apprunner_observability_configuration.test.arn
+    observability_enabled           = true
+  }
+}
+`, rName)
+}
+
+func testAccAppRunnerService_imageRepository_observabilityConfigurationDisabled(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+
+  observability_configuration {
+    observability_enabled = false
+  }
+}
+`, rName)
+}
+
 func testAccAppRunnerServiceConfigTags1(rName string, tagKey1 string, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_apprunner_service" "test" {
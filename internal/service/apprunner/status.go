@@ -70,6 +70,26 @@ func StatusCustomDomain(ctx context.Context, conn *apprunner.AppRunner, domainNa
 	}
 }
 
+func StatusObservabilityConfiguration(ctx context.Context, conn *apprunner.AppRunner, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &apprunner.DescribeObservabilityConfigurationInput{
+			ObservabilityConfigurationArn: aws.String(arn),
+		}
+
+		output, err := conn.DescribeObservabilityConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ObservabilityConfiguration == nil {
+			return nil, "", nil
+		}
+
+		return output.ObservabilityConfiguration, aws.StringValue(output.ObservabilityConfiguration.Status), nil
+	}
+}
+
 func StatusService(ctx context.Context, conn *apprunner.AppRunner, serviceArn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &apprunner.DescribeServiceInput{
@@ -89,3 +109,23 @@ func StatusService(ctx context.Context, conn *apprunner.AppRunner, serviceArn st
 		return output.Service, aws.StringValue(output.Service.Status), nil
 	}
 }
+
+func StatusVPCConnector(ctx context.Context, conn *apprunner.AppRunner, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &apprunner.DescribeVpcConnectorInput{
+			VpcConnectorArn: aws.String(arn),
+		}
+
+		output, err := conn.DescribeVpcConnectorWithContext(ctx, input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.VpcConnector == nil {
+			return nil, "", nil
+		}
+
+		return output.VpcConnector, aws.StringValue(output.VpcConnector.Status), nil
+	}
+}
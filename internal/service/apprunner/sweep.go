@@ -29,10 +29,22 @@ func init() {
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
+	resource.AddTestSweepers("aws_apprunner_observability_configuration", &resource.Sweeper{
+		Name:         "aws_apprunner_observability_configuration",
+		F:            sweepObservabilityConfigurations,
+		Dependencies: []string{"aws_apprunner_service"},
+	})
+
 	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
+
+	resource.AddTestSweepers("aws_apprunner_vpc_connector", &resource.Sweeper{
+		Name:         "aws_apprunner_vpc_connector",
+		F:            sweepVPCConnectors,
+		Dependencies: []string{"aws_apprunner_service"},
+	})
 }
 
 func sweepAutoScalingConfigurationVersions(region string) error {
@@ -161,6 +173,70 @@ func sweepConnections(region string) error {
 	return errs.ErrorOrNil()
 }
 
+func sweepObservabilityConfigurations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AppRunnerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &apprunner.ListObservabilityConfigurationsInput{}
+
+	err = conn.ListObservabilityConfigurationsPagesWithContext(ctx, input, func(page *apprunner.ListObservabilityConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summaryConfig := range page.ObservabilityConfigurationSummaryList {
+			if summaryConfig == nil {
+				continue
+			}
+
+			// Skip DefaultConfiguration as deletion is not supported by the App Runner service.
+			if aws.StringValue(summaryConfig.ObservabilityConfigurationName) == "DefaultConfiguration" {
+				log.Printf("[INFO] Skipping App Runner Observability Configuration: DefaultConfiguration")
+				continue
+			}
+
+			arn := aws.StringValue(summaryConfig.ObservabilityConfigurationArn)
+
+			log.Printf("[INFO] Deleting App Runner Observability Configuration (%s)", arn)
+			r := ResourceObservabilityConfiguration()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping App Runner Observability Configurations sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner Observability Configurations: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner Observability Configurations for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Runner Observability Configurations sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func sweepServices(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 
@@ -219,3 +295,62 @@ func sweepServices(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepVPCConnectors(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AppRunnerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &apprunner.ListVpcConnectorsInput{}
+
+	err = conn.ListVpcConnectorsPagesWithContext(ctx, input, func(page *apprunner.ListVpcConnectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, vpcConnector := range page.VpcConnectors {
+			if vpcConnector == nil {
+				continue
+			}
+
+			arn := aws.StringValue(vpcConnector.VpcConnectorArn)
+
+			log.Printf("[INFO] Deleting App Runner VPC Connector: %s", arn)
+
+			r := ResourceVPCConnector()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping App Runner VPC Connectors sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner VPC Connectors: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner VPC Connectors for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Runner VPC Connectors sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
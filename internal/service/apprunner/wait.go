@@ -17,9 +17,15 @@ const (
 	CustomDomainAssociationCreateTimeout = 5 * time.Minute
 	CustomDomainAssociationDeleteTimeout = 5 * time.Minute
 
+	ObservabilityConfigurationCreateTimeout = 2 * time.Minute
+	ObservabilityConfigurationDeleteTimeout = 2 * time.Minute
+
 	ServiceCreateTimeout = 20 * time.Minute
 	ServiceDeleteTimeout = 20 * time.Minute
 	ServiceUpdateTimeout = 20 * time.Minute
+
+	VPCConnectorCreateTimeout = 2 * time.Minute
+	VPCConnectorDeleteTimeout = 2 * time.Minute
 )
 
 func WaitAutoScalingConfigurationActive(ctx context.Context, conn *apprunner.AppRunner, arn string) error {
@@ -87,6 +93,32 @@ func WaitCustomDomainAssociationDeleted(ctx context.Context, conn *apprunner.App
 	return err
 }
 
+func WaitObservabilityConfigurationActive(ctx context.Context, conn *apprunner.AppRunner, arn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{apprunner.ObservabilityConfigurationStatusActive},
+		Refresh: StatusObservabilityConfiguration(ctx, conn, arn),
+		Timeout: ObservabilityConfigurationCreateTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func WaitObservabilityConfigurationInactive(ctx context.Context, conn *apprunner.AppRunner, arn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apprunner.ObservabilityConfigurationStatusActive},
+		Target:  []string{apprunner.ObservabilityConfigurationStatusInactive},
+		Refresh: StatusObservabilityConfiguration(ctx, conn, arn),
+		Timeout: ObservabilityConfigurationDeleteTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
 func WaitServiceCreated(ctx context.Context, conn *apprunner.AppRunner, serviceArn string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{apprunner.ServiceStatusOperationInProgress},
@@ -125,3 +157,29 @@ func WaitServiceDeleted(ctx context.Context, conn *apprunner.AppRunner, serviceA
 
 	return err
 }
+
+func WaitVPCConnectorActive(ctx context.Context, conn *apprunner.AppRunner, arn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{apprunner.VpcConnectorStatusActive},
+		Refresh: StatusVPCConnector(ctx, conn, arn),
+		Timeout: VPCConnectorCreateTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func WaitVPCConnectorInactive(ctx context.Context, conn *apprunner.AppRunner, arn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apprunner.VpcConnectorStatusActive},
+		Target:  []string{apprunner.VpcConnectorStatusInactive},
+		Refresh: StatusVPCConnector(ctx, conn, arn),
+		Timeout: VPCConnectorDeleteTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
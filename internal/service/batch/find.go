@@ -85,3 +85,24 @@ func FindJobDefinition(conn *batch.Batch, input *batch.DescribeJobDefinitionsInp
 
 	return output.JobDefinitions[0], nil
 }
+
+func FindSchedulingPolicyByARN(conn *batch.Batch, arn string) (*batch.SchedulingPolicyDetail, error) {
+	input := &batch.DescribeSchedulingPoliciesInput{
+		Arns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.DescribeSchedulingPolicies(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.SchedulingPolicies) == 0 || output.SchedulingPolicies[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.SchedulingPolicies[0], nil
+}
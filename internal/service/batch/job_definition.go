@@ -130,6 +130,12 @@ func ResourceJobDefinition() *schema.Resource {
 					},
 				},
 			},
+			"scheduling_priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 9999),
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"propagate_tags": {
@@ -207,6 +213,10 @@ func resourceJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error
 		input.RetryStrategy = expandBatchRetryStrategy(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("scheduling_priority"); ok {
+		input.SchedulingPriority = aws.Int64(int64(v.(int)))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -268,6 +278,8 @@ func resourceJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("retry_strategy", nil)
 	}
 
+	d.Set("scheduling_priority", jobDefinition.SchedulingPriority)
+
 	tags := KeyValueTags(jobDefinition.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
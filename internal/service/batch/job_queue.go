@@ -1,6 +1,7 @@
 package batch
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -46,6 +48,11 @@ func ResourceJobQueue() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"scheduling_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"state": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -59,7 +66,14 @@ func ResourceJobQueue() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// A job queue is either FIFO or fair share for its whole lifetime.
+			// Replacing one scheduling policy with another is allowed in place.
+			customdiff.ForceNewIfChange("scheduling_policy_arn", func(_ context.Context, old, new, meta interface{}) bool {
+				return (old.(string) == "") != (new.(string) == "")
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -74,6 +88,10 @@ func resourceJobQueueCreate(d *schema.ResourceData, meta interface{}) error {
 		State:                   aws.String(d.Get("state").(string)),
 	}
 
+	if v, ok := d.GetOk("scheduling_policy_arn"); ok {
+		input.SchedulingPolicyArn = aws.String(v.(string))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -138,6 +156,7 @@ func resourceJobQueueRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", jq.JobQueueName)
 	d.Set("priority", jq.Priority)
+	d.Set("scheduling_policy_arn", jq.SchedulingPolicyArn)
 	d.Set("state", jq.State)
 
 	tags := KeyValueTags(jq.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
@@ -157,7 +176,7 @@ func resourceJobQueueRead(d *schema.ResourceData, meta interface{}) error {
 func resourceJobQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).BatchConn
 
-	if d.HasChanges("compute_environments", "priority", "state") {
+	if d.HasChanges("compute_environments", "priority", "scheduling_policy_arn", "state") {
 		name := d.Get("name").(string)
 		updateInput := &batch.UpdateJobQueueInput{
 			ComputeEnvironmentOrder: createComputeEnvironmentOrder(d.Get("compute_environments").([]interface{})),
@@ -165,6 +184,9 @@ func resourceJobQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 			Priority:                aws.Int64(int64(d.Get("priority").(int))),
 			State:                   aws.String(d.Get("state").(string)),
 		}
+		if d.HasChange("scheduling_policy_arn") {
+			updateInput.SchedulingPolicyArn = aws.String(d.Get("scheduling_policy_arn").(string))
+		}
 		_, err := conn.UpdateJobQueue(updateInput)
 		if err != nil {
 			return err
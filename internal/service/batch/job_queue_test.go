@@ -213,6 +213,42 @@ func TestAccBatchJobQueue_tags(t *testing.T) {
 	})
 }
 
+func TestAccBatchJobQueue_schedulingPolicy(t *testing.T) {
+	var jobQueue1 batch.JobQueueDetail
+	resourceName := "aws_batch_job_queue.test"
+	schedulingPolicyName1 := "aws_batch_scheduling_policy.test1"
+	schedulingPolicyName2 := "aws_batch_scheduling_policy.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBatchJobQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchJobQueueConfigSchedulingPolicy(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobQueueExists(resourceName, &jobQueue1),
+					resource.TestCheckResourceAttrPair(resourceName, "scheduling_policy_arn", schedulingPolicyName1, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBatchJobQueueConfigSchedulingPolicy(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobQueueExists(resourceName, &jobQueue1),
+					resource.TestCheckResourceAttrPair(resourceName, "scheduling_policy_arn", schedulingPolicyName2, "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBatchJobQueueExists(n string, jq *batch.JobQueueDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -429,6 +465,48 @@ resource "aws_batch_job_queue" "test" {
 `, rName, state))
 }
 
+func testAccBatchJobQueueConfigSchedulingPolicy(rName, schedulingPolicyResourceName string) string {
+	return acctest.ConfigCompose(
+		testAccBatchJobQueueConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test1" {
+  name = "%[1]s-1"
+
+  fairshare_policy {
+    compute_reservation = 1
+    share_decay_seconds = 3600
+
+    share_distribution {
+      share_identifier = "A1*"
+      weight_factor    = 0.1
+    }
+  }
+}
+
+resource "aws_batch_scheduling_policy" "test2" {
+  name = "%[1]s-2"
+
+  fairshare_policy {
+    compute_reservation = 1
+    share_decay_seconds = 3600
+
+    share_distribution {
+      share_identifier = "A2"
+      weight_factor    = 0.2
+    }
+  }
+}
+
+resource "aws_batch_job_queue" "test" {
+  compute_environments  = [aws_batch_compute_environment.test.arn]
+  name                  = %[1]q
+  priority              = 1
+  scheduling_policy_arn = aws_batch_scheduling_policy.%[2]s.arn
+  state                 = "ENABLED"
+}
+`, rName, schedulingPolicyResourceName))
+}
+
 func testAccBatchJobQueueConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccBatchJobQueueConfigBase(rName),
@@ -0,0 +1,258 @@
+package batch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSchedulingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSchedulingPolicyCreate,
+		Read:   resourceSchedulingPolicyRead,
+		Update: resourceSchedulingPolicyUpdate,
+		Delete: resourceSchedulingPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fairshare_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_reservation": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 99),
+						},
+						"share_decay_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 604800),
+						},
+						"share_distribution": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 500,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"share_identifier": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"weight_factor": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.FloatBetween(0.0001, 999.9999),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSchedulingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BatchConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &batch.CreateSchedulingPolicyInput{
+		FairsharePolicy: expandFairsharePolicy(d.Get("fairshare_policy").([]interface{})),
+		Name:            aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Batch Scheduling Policy: %s", input)
+	output, err := conn.CreateSchedulingPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Batch Scheduling Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return resourceSchedulingPolicyRead(d, meta)
+}
+
+func resourceSchedulingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BatchConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	sp, err := FindSchedulingPolicyByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Batch Scheduling Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Batch Scheduling Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", sp.Arn)
+	if err := d.Set("fairshare_policy", flattenFairsharePolicy(sp.FairsharePolicy)); err != nil {
+		return fmt.Errorf("error setting fairshare_policy: %w", err)
+	}
+	d.Set("name", sp.Name)
+
+	tags := KeyValueTags(sp.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSchedulingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BatchConn
+
+	if d.HasChange("fairshare_policy") {
+		input := &batch.UpdateSchedulingPolicyInput{
+			Arn:             aws.String(d.Id()),
+			FairsharePolicy: expandFairsharePolicy(d.Get("fairshare_policy").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Batch Scheduling Policy: %s", input)
+		_, err := conn.UpdateSchedulingPolicy(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Batch Scheduling Policy (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Batch Scheduling Policy (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSchedulingPolicyRead(d, meta)
+}
+
+func resourceSchedulingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BatchConn
+
+	log.Printf("[DEBUG] Deleting Batch Scheduling Policy: %s", d.Id())
+	_, err := conn.DeleteSchedulingPolicy(&batch.DeleteSchedulingPolicyInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deleting Batch Scheduling Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFairsharePolicy(tfList []interface{}) *batch.FairsharePolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &batch.FairsharePolicy{
+		ComputeReservation: aws.Int64(int64(tfMap["compute_reservation"].(int))),
+		ShareDecaySeconds:  aws.Int64(int64(tfMap["share_decay_seconds"].(int))),
+	}
+
+	if v, ok := tfMap["share_distribution"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			shareAttributes := &batch.ShareAttributes{
+				ShareIdentifier: aws.String(tfMap["share_identifier"].(string)),
+			}
+
+			if v, ok := tfMap["weight_factor"].(float64); ok && v != 0 {
+				shareAttributes.WeightFactor = aws.Float64(v)
+			}
+
+			apiObject.ShareDistribution = append(apiObject.ShareDistribution, shareAttributes)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenFairsharePolicy(apiObject *batch.FairsharePolicy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	// A policy created without a fair share policy reports an empty one.
+	if aws.Int64Value(apiObject.ComputeReservation) == 0 && aws.Int64Value(apiObject.ShareDecaySeconds) == 0 && len(apiObject.ShareDistribution) == 0 {
+		return nil
+	}
+
+	shareDistribution := make([]interface{}, 0, len(apiObject.ShareDistribution))
+
+	for _, v := range apiObject.ShareDistribution {
+		if v == nil {
+			continue
+		}
+
+		shareDistribution = append(shareDistribution, map[string]interface{}{
+			"share_identifier": aws.StringValue(v.ShareIdentifier),
+			"weight_factor":    aws.Float64Value(v.WeightFactor),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"compute_reservation": aws.Int64Value(apiObject.ComputeReservation),
+		"share_decay_seconds": aws.Int64Value(apiObject.ShareDecaySeconds),
+		"share_distribution":  shareDistribution,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,87 @@
+package batch
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceSchedulingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSchedulingPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"fairshare_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_reservation": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"share_decay_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"share_distribution": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"share_identifier": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"weight_factor": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceSchedulingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BatchConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	arn := d.Get("arn").(string)
+	sp, err := FindSchedulingPolicyByARN(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Batch Scheduling Policy (%s): %w", arn, err)
+	}
+
+	d.SetId(aws.StringValue(sp.Arn))
+	d.Set("arn", sp.Arn)
+	if err := d.Set("fairshare_policy", flattenFairsharePolicy(sp.FairsharePolicy)); err != nil {
+		return fmt.Errorf("error setting fairshare_policy: %w", err)
+	}
+	d.Set("name", sp.Name)
+
+	if err := d.Set("tags", KeyValueTags(sp.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package batch_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccBatchSchedulingPolicyDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_scheduling_policy.test"
+	dataSourceName := "data.aws_batch_scheduling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulingPolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fairshare_policy.#", resourceName, "fairshare_policy.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fairshare_policy.0.compute_reservation", resourceName, "fairshare_policy.0.compute_reservation"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fairshare_policy.0.share_decay_seconds", resourceName, "fairshare_policy.0.share_decay_seconds"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fairshare_policy.0.share_distribution.#", resourceName, "fairshare_policy.0.share_distribution.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchedulingPolicyDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccSchedulingPolicyConfigBasic(rName), `
+data "aws_batch_scheduling_policy" "test" {
+  arn = aws_batch_scheduling_policy.test.arn
+}
+`)
+}
@@ -0,0 +1,319 @@
+package batch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBatchSchedulingPolicy_basic(t *testing.T) {
+	var schedulingPolicy1 batch.SchedulingPolicyDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_scheduling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSchedulingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulingPolicyConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(resourceName, &schedulingPolicy1),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "batch", fmt.Sprintf("scheduling-policy/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.0.compute_reservation", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.0.share_decay_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.0.share_distribution.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "fairshare_policy.0.share_distribution.*", map[string]string{
+						"share_identifier": "A1*",
+						"weight_factor":    "0.1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchedulingPolicyConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(resourceName, &schedulingPolicy1),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.0.compute_reservation", "5"),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.0.share_decay_seconds", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.0.share_distribution.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "fairshare_policy.0.share_distribution.*", map[string]string{
+						"share_identifier": "team-a",
+						"weight_factor":    "0.5",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchSchedulingPolicy_defaults(t *testing.T) {
+	var schedulingPolicy1 batch.SchedulingPolicyDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_scheduling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSchedulingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulingPolicyConfigNoFairsharePolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(resourceName, &schedulingPolicy1),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchedulingPolicyConfigNoWeightFactor(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(resourceName, &schedulingPolicy1),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fairshare_policy.0.share_distribution.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "fairshare_policy.0.share_distribution.*", map[string]string{
+						"share_identifier": "A1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchSchedulingPolicy_disappears(t *testing.T) {
+	var schedulingPolicy1 batch.SchedulingPolicyDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_scheduling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSchedulingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulingPolicyConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(resourceName, &schedulingPolicy1),
+					acctest.CheckResourceDisappears(acctest.Provider, tfbatch.ResourceSchedulingPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchSchedulingPolicy_tags(t *testing.T) {
+	var schedulingPolicy1 batch.SchedulingPolicyDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_scheduling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSchedulingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulingPolicyConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(resourceName, &schedulingPolicy1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchedulingPolicyConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(resourceName, &schedulingPolicy1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSchedulingPolicyConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(resourceName, &schedulingPolicy1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSchedulingPolicyExists(n string, v *batch.SchedulingPolicyDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Batch Scheduling Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BatchConn
+
+		output, err := tfbatch.FindSchedulingPolicyByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSchedulingPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BatchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_batch_scheduling_policy" {
+			continue
+		}
+
+		_, err := tfbatch.FindSchedulingPolicyByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Batch Scheduling Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSchedulingPolicyConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test" {
+  name = %[1]q
+
+  fairshare_policy {
+    compute_reservation = 1
+    share_decay_seconds = 3600
+
+    share_distribution {
+      share_identifier = "A1*"
+      weight_factor    = 0.1
+    }
+
+    share_distribution {
+      share_identifier = "A2"
+      weight_factor    = 0.2
+    }
+  }
+}
+`, rName)
+}
+
+func testAccSchedulingPolicyConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test" {
+  name = %[1]q
+
+  fairshare_policy {
+    compute_reservation = 5
+    share_decay_seconds = 7200
+
+    share_distribution {
+      share_identifier = "team-a"
+      weight_factor    = 0.5
+    }
+  }
+}
+`, rName)
+}
+
+func testAccSchedulingPolicyConfigNoFairsharePolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSchedulingPolicyConfigNoWeightFactor(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test" {
+  name = %[1]q
+
+  fairshare_policy {
+    share_distribution {
+      share_identifier = "A1"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccSchedulingPolicyConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test" {
+  name = %[1]q
+
+  fairshare_policy {
+    compute_reservation = 1
+    share_decay_seconds = 3600
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSchedulingPolicyConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test" {
+  name = %[1]q
+
+  fairshare_policy {
+    compute_reservation = 1
+    share_decay_seconds = 3600
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
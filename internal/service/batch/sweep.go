@@ -39,6 +39,14 @@ func init() {
 		Name: "aws_batch_job_queue",
 		F:    sweepJobQueues,
 	})
+
+	resource.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
+		Name: "aws_batch_scheduling_policy",
+		F:    sweepSchedulingPolicies,
+		Dependencies: []string{
+			"aws_batch_job_queue",
+		},
+	})
 }
 
 func sweepComputeEnvironments(region string) error {
@@ -243,3 +251,46 @@ func sweepJobQueues(region string) error {
 
 	return nil
 }
+
+func sweepSchedulingPolicies(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).BatchConn
+	input := &batch.ListSchedulingPoliciesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListSchedulingPoliciesPages(input, func(page *batch.ListSchedulingPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SchedulingPolicies {
+			r := ResourceSchedulingPolicy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Batch Scheduling Policy sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Batch Scheduling Policies (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Batch Scheduling Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBackupCreate,
+		ReadWithoutTimeout:   resourceBackupRead,
+		DeleteWithoutTimeout: resourceBackupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"backup_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"backup_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	backupName := d.Get("backup_name").(string)
+	tableName := d.Get("table_name").(string)
+
+	input := &dynamodb.CreateBackupInput{
+		BackupName: aws.String(backupName),
+		TableName:  aws.String(tableName),
+	}
+
+	output, err := conn.CreateBackupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating DynamoDB Backup (%s) of table (%s): %w", backupName, tableName, err))
+	}
+
+	if output == nil || output.BackupDetails == nil {
+		return diag.FromErr(fmt.Errorf("error creating DynamoDB Backup (%s) of table (%s): empty output", backupName, tableName))
+	}
+
+	d.SetId(aws.StringValue(output.BackupDetails.BackupArn))
+
+	if _, err := waitDynamoDBBackupAvailable(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for DynamoDB Backup (%s) to be available: %w", d.Id(), err))
+	}
+
+	return resourceBackupRead(ctx, d, meta)
+}
+
+func resourceBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	output, err := FindDynamoDBBackupByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeBackupNotFoundException) {
+		log.Printf("[WARN] DynamoDB Backup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading DynamoDB Backup (%s): %w", d.Id(), err))
+	}
+
+	if output == nil || output.BackupDetails == nil || aws.StringValue(output.BackupDetails.BackupStatus) == dynamodb.BackupStatusDeleted {
+		if d.IsNewResource() {
+			return diag.FromErr(fmt.Errorf("error reading DynamoDB Backup (%s): empty output after creation", d.Id()))
+		}
+		log.Printf("[WARN] DynamoDB Backup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	details := output.BackupDetails
+
+	d.Set("arn", details.BackupArn)
+	if details.BackupCreationDateTime != nil {
+		d.Set("backup_creation_date_time", aws.TimeValue(details.BackupCreationDateTime).Format(time.RFC3339))
+	}
+	d.Set("backup_name", details.BackupName)
+	d.Set("backup_size_bytes", details.BackupSizeBytes)
+	d.Set("backup_status", details.BackupStatus)
+	d.Set("backup_type", details.BackupType)
+
+	if table := output.SourceTableDetails; table != nil {
+		d.Set("table_arn", table.TableArn)
+		d.Set("table_id", table.TableId)
+		d.Set("table_name", table.TableName)
+	}
+
+	return nil
+}
+
+func resourceBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	log.Printf("[DEBUG] Deleting DynamoDB Backup: %s", d.Id())
+	_, err := conn.DeleteBackupWithContext(ctx, &dynamodb.DeleteBackupInput{
+		BackupArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeBackupNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting DynamoDB Backup (%s): %w", d.Id(), err))
+	}
+
+	if _, err := waitDynamoDBBackupDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for DynamoDB Backup (%s) deletion: %w", d.Id(), err))
+	}
+
+	return nil
+}
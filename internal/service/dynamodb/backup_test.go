@@ -0,0 +1,148 @@
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestAccDynamoDBBackup_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_backup.test"
+	tableResourceName := "aws_dynamodb_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBackupExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dynamodb", regexp.MustCompile(fmt.Sprintf("table/%s/backup/.+", rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "backup_creation_date_time"),
+					resource.TestCheckResourceAttr(resourceName, "backup_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "backup_status", dynamodb.BackupStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "backup_type", dynamodb.BackupTypeUser),
+					resource.TestCheckResourceAttrPair(resourceName, "table_arn", tableResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "table_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_name", tableResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBBackup_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_backup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBackupExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdynamodb.ResourceBackup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBackupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Backup ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := tfdynamodb.FindDynamoDBBackupByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.BackupDetails == nil {
+			return fmt.Errorf("DynamoDB Backup (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBackupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_backup" {
+			continue
+		}
+
+		output, err := tfdynamodb.FindDynamoDBBackupByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeBackupNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.BackupDetails != nil && aws.StringValue(output.BackupDetails.BackupStatus) != dynamodb.BackupStatusDeleted {
+			return fmt.Errorf("DynamoDB Backup (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccBackupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_backup" "test" {
+  backup_name = %[1]q
+  table_name  = aws_dynamodb_table.test.name
+}
+`, rName)
+}
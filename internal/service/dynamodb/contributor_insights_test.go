@@ -0,0 +1,208 @@
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestAccDynamoDBContributorInsights_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_contributor_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBContributorInsights_indexName(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_contributor_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightsConfig_indexName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBContributorInsights_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_contributor_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightsExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdynamodb.ResourceContributorInsights(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContributorInsightsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Contributor Insights ID is set")
+		}
+
+		tableName, indexName, err := tfdynamodb.ContributorInsightsParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := tfdynamodb.FindDynamoDBContributorInsights(context.Background(), conn, tableName, indexName)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.ContributorInsightsStatus) != dynamodb.ContributorInsightsStatusEnabled {
+			return fmt.Errorf("DynamoDB Contributor Insights (%s) not enabled", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckContributorInsightsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_contributor_insights" {
+			continue
+		}
+
+		tableName, indexName, err := tfdynamodb.ContributorInsightsParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfdynamodb.FindDynamoDBContributorInsights(context.Background(), conn, tableName, indexName)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.ContributorInsightsStatus) != dynamodb.ContributorInsightsStatusDisabled {
+			return fmt.Errorf("DynamoDB Contributor Insights %s still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContributorInsightsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 2
+  write_capacity = 2
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = %[1]q
+    hash_key        = "TestGSIHashKey"
+    projection_type = "KEYS_ONLY"
+    read_capacity   = 1
+    write_capacity  = 1
+  }
+}
+`, rName)
+}
+
+func testAccContributorInsightsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccContributorInsightsConfigBase(rName),
+		`
+resource "aws_dynamodb_contributor_insights" "test" {
+  table_name = aws_dynamodb_table.test.name
+}
+`)
+}
+
+func testAccContributorInsightsConfig_indexName(rName string) string {
+	return acctest.ConfigCompose(
+		testAccContributorInsightsConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_dynamodb_contributor_insights" "test" {
+  table_name = aws_dynamodb_table.test.name
+  index_name = %[1]q
+}
+`, rName))
+}
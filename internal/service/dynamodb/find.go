@@ -119,3 +119,57 @@ func FindDynamoDBTTLRDescriptionByTableName(conn *dynamodb.DynamoDB, tableName s
 
 	return output.TimeToLiveDescription, nil
 }
+
+func FindDynamoDBBackupByARN(ctx context.Context, conn *dynamodb.DynamoDB, arn string) (*dynamodb.BackupDescription, error) {
+	input := &dynamodb.DescribeBackupInput{
+		BackupArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeBackupWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.BackupDescription, nil
+}
+
+func FindDynamoDBContributorInsights(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	input := &dynamodb.DescribeContributorInsightsInput{
+		TableName: aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	output, err := conn.DescribeContributorInsightsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindDynamoDBTableExportByARN(ctx context.Context, conn *dynamodb.DynamoDB, arn string) (*dynamodb.ExportDescription, error) {
+	input := &dynamodb.DescribeExportInput{
+		ExportArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExportWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ExportDescription, nil
+}
@@ -184,3 +184,64 @@ func statusDynamoDBTableSES(conn *dynamodb.DynamoDB, tableName string) resource.
 		return table, aws.StringValue(table.SSEDescription.Status), nil
 	}
 }
+
+func statusDynamoDBBackup(ctx context.Context, conn *dynamodb.DynamoDB, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := FindDynamoDBBackupByARN(ctx, conn, arn)
+
+		// Deleted backups are only briefly described with a DELETED status.
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeBackupNotFoundException) {
+			return &dynamodb.BackupDescription{}, dynamodb.BackupStatusDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if backup == nil || backup.BackupDetails == nil {
+			return nil, "", nil
+		}
+
+		return backup, aws.StringValue(backup.BackupDetails.BackupStatus), nil
+	}
+}
+
+func statusDynamoDBContributorInsights(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDynamoDBContributorInsights(ctx, conn, tableName, indexName)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			return &dynamodb.DescribeContributorInsightsOutput{}, dynamodb.ContributorInsightsStatusDisabled, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.ContributorInsightsStatus), nil
+	}
+}
+
+func statusDynamoDBTableExport(ctx context.Context, conn *dynamodb.DynamoDB, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		export, err := FindDynamoDBTableExportByARN(ctx, conn, arn)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeExportNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if export == nil {
+			return nil, "", nil
+		}
+
+		return export, aws.StringValue(export.ExportStatus), nil
+	}
+}
@@ -17,6 +17,11 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+		Name: "aws_dynamodb_backup",
+		F:    sweepBackups,
+	})
+
 	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
@@ -94,3 +99,57 @@ func sweepTables(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepBackups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).DynamoDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &dynamodb.ListBackupsInput{
+		BackupType: aws.String(dynamodb.BackupTypeFilterUser),
+	}
+
+	for {
+		output, err := conn.ListBackups(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing DynamoDB Backups for %s: %w", region, err))
+			break
+		}
+
+		for _, backup := range output.BackupSummaries {
+			if aws.StringValue(backup.BackupStatus) == dynamodb.BackupStatusDeleted {
+				continue
+			}
+
+			r := ResourceBackup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(backup.BackupArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.LastEvaluatedBackupArn) == "" {
+			break
+		}
+
+		input.ExclusiveStartBackupArn = output.LastEvaluatedBackupArn
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DynamoDB Backups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DynamoDB Backups sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
@@ -48,6 +49,9 @@ func ResourceTable() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return validateDynamoDbTableAttributes(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return validTableRestore(diff)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				if diff.Id() != "" && diff.HasChange("server_side_encryption") {
 					o, n := diff.GetChange("server_side_encryption")
@@ -239,6 +243,34 @@ func ResourceTable() *schema.Resource {
 					},
 				},
 			},
+			"restore_backup_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{"restore_date_time", "restore_source_name", "restore_to_latest_time"},
+			},
+			"restore_date_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"restore_backup_arn", "restore_to_latest_time"},
+				RequiredWith:  []string{"restore_source_name"},
+			},
+			"restore_source_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"restore_backup_arn"},
+			},
+			"restore_to_latest_time": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"restore_backup_arn", "restore_date_time"},
+				RequiredWith:  []string{"restore_source_name"},
+			},
 			"server_side_encryption": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -334,15 +366,6 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		keySchemaMap["range_key"] = v.(string)
 	}
 
-	log.Printf("[DEBUG] Creating DynamoDB table with key schema: %#v", keySchemaMap)
-
-	req := &dynamodb.CreateTableInput{
-		TableName:   aws.String(d.Get("name").(string)),
-		BillingMode: aws.String(d.Get("billing_mode").(string)),
-		KeySchema:   expandDynamoDbKeySchema(keySchemaMap),
-		Tags:        Tags(tags.IgnoreAWS()),
-	}
-
 	billingMode := d.Get("billing_mode").(string)
 	capacityMap := map[string]interface{}{
 		"write_capacity": d.Get("write_capacity"),
@@ -353,20 +376,9 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	req.ProvisionedThroughput = expandDynamoDbProvisionedThroughput(capacityMap, billingMode)
-
-	if v, ok := d.GetOk("attribute"); ok {
-		aSet := v.(*schema.Set)
-		req.AttributeDefinitions = expandDynamoDbAttributes(aSet.List())
-	}
-
-	if v, ok := d.GetOk("local_secondary_index"); ok {
-		lsiSet := v.(*schema.Set)
-		req.LocalSecondaryIndexes = expandDynamoDbLocalSecondaryIndexes(lsiSet.List(), keySchemaMap)
-	}
+	var globalSecondaryIndexes []*dynamodb.GlobalSecondaryIndex
 
 	if v, ok := d.GetOk("global_secondary_index"); ok {
-		globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
 		gsiSet := v.(*schema.Set)
 
 		for _, gsiObject := range gsiSet.List() {
@@ -378,68 +390,133 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 			gsiObject := expandDynamoDbGlobalSecondaryIndex(gsi, billingMode)
 			globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
 		}
-		req.GlobalSecondaryIndexes = globalSecondaryIndexes
 	}
 
-	if v, ok := d.GetOk("stream_enabled"); ok {
-		req.StreamSpecification = &dynamodb.StreamSpecification{
-			StreamEnabled:  aws.Bool(v.(bool)),
-			StreamViewType: aws.String(d.Get("stream_view_type").(string)),
-		}
-	}
+	var table *dynamodb.TableDescription
+	var requiresTagging bool
 
-	if v, ok := d.GetOk("server_side_encryption"); ok {
-		req.SSESpecification = expandDynamoDbEncryptAtRestOptions(v.([]interface{}))
-	}
+	_, restoreFromBackup := d.GetOk("restore_backup_arn")
+	_, restoreToPointInTime := d.GetOk("restore_source_name")
+	restoring := restoreFromBackup || restoreToPointInTime
 
-	var output *dynamodb.CreateTableOutput
-	var requiresTagging bool
-	err := resource.Retry(createTableTimeout, func() *resource.RetryError {
+	if restoring {
 		var err error
-		output, err = conn.CreateTable(req)
+		table, err = restoreDynamoDbTable(conn, d, keySchemaMap, globalSecondaryIndexes)
+
 		if err != nil {
-			if tfawserr.ErrMessageContains(err, "ThrottlingException", "") {
-				return resource.RetryableError(err)
-			}
-			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
-				return resource.RetryableError(err)
-			}
-			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "indexed tables that can be created simultaneously") {
-				return resource.RetryableError(err)
-			}
-			// AWS GovCloud (US) and others may reply with the following until their API is updated:
-			// ValidationException: One or more parameter values were invalid: Unsupported input parameter BillingMode
-			if tfawserr.ErrMessageContains(err, "ValidationException", "Unsupported input parameter BillingMode") {
-				req.BillingMode = nil
-				return resource.RetryableError(err)
+			return fmt.Errorf("error restoring DynamoDB Table (%s): %w", d.Get("name").(string), err)
+		}
+
+		// Neither restore operation accepts tags.
+		requiresTagging = len(tags) > 0
+	} else {
+		log.Printf("[DEBUG] Creating DynamoDB table with key schema: %#v", keySchemaMap)
+
+		req := &dynamodb.CreateTableInput{
+			TableName:   aws.String(d.Get("name").(string)),
+			BillingMode: aws.String(billingMode),
+			KeySchema:   expandDynamoDbKeySchema(keySchemaMap),
+			Tags:        Tags(tags.IgnoreAWS()),
+		}
+
+		req.ProvisionedThroughput = expandDynamoDbProvisionedThroughput(capacityMap, billingMode)
+
+		if v, ok := d.GetOk("attribute"); ok {
+			aSet := v.(*schema.Set)
+			req.AttributeDefinitions = expandDynamoDbAttributes(aSet.List())
+		}
+
+		if v, ok := d.GetOk("local_secondary_index"); ok {
+			lsiSet := v.(*schema.Set)
+			req.LocalSecondaryIndexes = expandDynamoDbLocalSecondaryIndexes(lsiSet.List(), keySchemaMap)
+		}
+
+		if len(globalSecondaryIndexes) > 0 {
+			req.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("stream_enabled"); ok {
+			req.StreamSpecification = &dynamodb.StreamSpecification{
+				StreamEnabled:  aws.Bool(v.(bool)),
+				StreamViewType: aws.String(d.Get("stream_view_type").(string)),
 			}
-			// AWS GovCloud (US) and others may reply with the following until their API is updated:
-			// ValidationException: Unsupported input parameter Tags
-			if tfawserr.ErrMessageContains(err, "ValidationException", "Unsupported input parameter Tags") {
-				req.Tags = nil
-				requiresTagging = true
-				return resource.RetryableError(err)
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			req.SSESpecification = expandDynamoDbEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		var output *dynamodb.CreateTableOutput
+		err := resource.Retry(createTableTimeout, func() *resource.RetryError {
+			var err error
+			output, err = conn.CreateTable(req)
+			if err != nil {
+				if tfawserr.ErrMessageContains(err, "ThrottlingException", "") {
+					return resource.RetryableError(err)
+				}
+				if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+					return resource.RetryableError(err)
+				}
+				if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "indexed tables that can be created simultaneously") {
+					return resource.RetryableError(err)
+				}
+				// AWS GovCloud (US) and others may reply with the following until their API is updated:
+				// ValidationException: One or more parameter values were invalid: Unsupported input parameter BillingMode
+				if tfawserr.ErrMessageContains(err, "ValidationException", "Unsupported input parameter BillingMode") {
+					req.BillingMode = nil
+					return resource.RetryableError(err)
+				}
+				// AWS GovCloud (US) and others may reply with the following until their API is updated:
+				// ValidationException: Unsupported input parameter Tags
+				if tfawserr.ErrMessageContains(err, "ValidationException", "Unsupported input parameter Tags") {
+					req.Tags = nil
+					requiresTagging = true
+					return resource.RetryableError(err)
+				}
+
+				return resource.NonRetryableError(err)
 			}
+			return nil
+		})
 
-			return resource.NonRetryableError(err)
+		if tfresource.TimedOut(err) {
+			output, err = conn.CreateTable(req)
 		}
-		return nil
-	})
 
-	if tfresource.TimedOut(err) {
-		output, err = conn.CreateTable(req)
-	}
+		if err != nil {
+			return fmt.Errorf("error creating DynamoDB Table: %w", err)
+		}
 
-	if err != nil {
-		return fmt.Errorf("error creating DynamoDB Table: %w", err)
-	}
+		if output == nil || output.TableDescription == nil {
+			return fmt.Errorf("error creating DynamoDB Table: empty response")
+		}
 
-	if output == nil || output.TableDescription == nil {
-		return fmt.Errorf("error creating DynamoDB Table: empty response")
+		table = output.TableDescription
 	}
 
-	d.SetId(aws.StringValue(output.TableDescription.TableName))
-	d.Set("arn", output.TableDescription.TableArn)
+	d.SetId(aws.StringValue(table.TableName))
+	d.Set("arn", table.TableArn)
+
+	if restoring {
+		// Restoring copies all table data and can take considerably longer than creating an empty table.
+		if _, err := waitDynamoDBTableRestored(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for restore of DynamoDB table (%s): %w", d.Id(), err)
+		}
+
+		if d.Get("stream_enabled").(bool) {
+			input := &dynamodb.UpdateTableInput{
+				StreamSpecification: &dynamodb.StreamSpecification{
+					StreamEnabled:  aws.Bool(true),
+					StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+				},
+				TableName: aws.String(d.Id()),
+			}
+
+			if _, err := conn.UpdateTable(input); err != nil {
+				return fmt.Errorf("error enabling DynamoDB Table (%s) stream: %w", d.Id(), err)
+			}
+		}
+	}
 
 	if _, err := waitDynamoDBTableActive(conn, d.Id()); err != nil {
 		return fmt.Errorf("error waiting for creation of DynamoDB table (%s): %w", d.Id(), err)
@@ -825,6 +902,118 @@ func isDynamoDbTableOptionDisabled(v interface{}) bool {
 
 // CRUD helpers
 
+// restoreDynamoDbTable creates the table from an on-demand backup or from a point in time
+// of a source table. Index, billing and encryption settings are applied as restore overrides.
+func restoreDynamoDbTable(conn *dynamodb.DynamoDB, d *schema.ResourceData, keySchemaMap map[string]interface{}, globalSecondaryIndexes []*dynamodb.GlobalSecondaryIndex) (*dynamodb.TableDescription, error) {
+	tableName := d.Get("name").(string)
+	billingMode := d.Get("billing_mode").(string)
+	capacityMap := map[string]interface{}{
+		"write_capacity": d.Get("write_capacity"),
+		"read_capacity":  d.Get("read_capacity"),
+	}
+
+	var localSecondaryIndexes []*dynamodb.LocalSecondaryIndex
+
+	if v, ok := d.GetOk("local_secondary_index"); ok {
+		localSecondaryIndexes = expandDynamoDbLocalSecondaryIndexes(v.(*schema.Set).List(), keySchemaMap)
+	}
+
+	var sseSpecification *dynamodb.SSESpecification
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		sseSpecification = expandDynamoDbEncryptAtRestOptions(v.([]interface{}))
+	}
+
+	var restore func() (*dynamodb.TableDescription, error)
+
+	if v, ok := d.GetOk("restore_backup_arn"); ok {
+		input := &dynamodb.RestoreTableFromBackupInput{
+			BackupArn:                     aws.String(v.(string)),
+			BillingModeOverride:           aws.String(billingMode),
+			GlobalSecondaryIndexOverride:  globalSecondaryIndexes,
+			LocalSecondaryIndexOverride:   localSecondaryIndexes,
+			ProvisionedThroughputOverride: expandDynamoDbProvisionedThroughput(capacityMap, billingMode),
+			SSESpecificationOverride:      sseSpecification,
+			TargetTableName:               aws.String(tableName),
+		}
+
+		log.Printf("[DEBUG] Restoring DynamoDB Table from backup: %s", input)
+		restore = func() (*dynamodb.TableDescription, error) {
+			output, err := conn.RestoreTableFromBackup(input)
+
+			if err != nil || output == nil {
+				return nil, err
+			}
+
+			return output.TableDescription, nil
+		}
+	} else {
+		input := &dynamodb.RestoreTableToPointInTimeInput{
+			BillingModeOverride:           aws.String(billingMode),
+			GlobalSecondaryIndexOverride:  globalSecondaryIndexes,
+			LocalSecondaryIndexOverride:   localSecondaryIndexes,
+			ProvisionedThroughputOverride: expandDynamoDbProvisionedThroughput(capacityMap, billingMode),
+			SourceTableName:               aws.String(d.Get("restore_source_name").(string)),
+			SSESpecificationOverride:      sseSpecification,
+			TargetTableName:               aws.String(tableName),
+		}
+
+		if v, ok := d.GetOk("restore_date_time"); ok {
+			t, _ := time.Parse(time.RFC3339, v.(string))
+			input.RestoreDateTime = aws.Time(t)
+		}
+
+		if v, ok := d.GetOk("restore_to_latest_time"); ok {
+			input.UseLatestRestorableTime = aws.Bool(v.(bool))
+		}
+
+		log.Printf("[DEBUG] Restoring DynamoDB Table to point in time: %s", input)
+		restore = func() (*dynamodb.TableDescription, error) {
+			output, err := conn.RestoreTableToPointInTime(input)
+
+			if err != nil || output == nil {
+				return nil, err
+			}
+
+			return output.TableDescription, nil
+		}
+	}
+
+	var table *dynamodb.TableDescription
+	err := resource.Retry(createTableTimeout, func() *resource.RetryError {
+		var err error
+		table, err = restore()
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, "ThrottlingException", "") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "indexed tables that can be created simultaneously") {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		table, err = restore()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if table == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return table, nil
+}
+
 func createDynamoDbReplicas(tableName string, tfList []interface{}, conn *dynamodb.DynamoDB) error {
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -912,10 +1101,12 @@ func updateDynamoDbTimeToLive(tableName string, ttlList []interface{}, conn *dyn
 }
 
 func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
-	toEnable := d.Get("point_in_time_recovery.0.enabled").(bool)
+	return updateDynamoDbTablePITR(conn, d.Id(), d.Get("point_in_time_recovery.0.enabled").(bool))
+}
 
+func updateDynamoDbTablePITR(conn *dynamodb.DynamoDB, tableName string, toEnable bool) error {
 	input := &dynamodb.UpdateContinuousBackupsInput{
-		TableName: aws.String(d.Id()),
+		TableName: aws.String(tableName),
 		PointInTimeRecoverySpecification: &dynamodb.PointInTimeRecoverySpecification{
 			PointInTimeRecoveryEnabled: aws.Bool(toEnable),
 		},
@@ -941,7 +1132,7 @@ func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
 		return fmt.Errorf("error updating DynamoDB PITR status: %w", err)
 	}
 
-	if _, err := waitDynamoDBPITRUpdated(conn, d.Id(), toEnable); err != nil {
+	if _, err := waitDynamoDBPITRUpdated(conn, tableName, toEnable); err != nil {
 		return fmt.Errorf("error waiting for DynamoDB PITR update: %w", err)
 	}
 
@@ -0,0 +1,565 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTableReplica() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTableReplicaCreate,
+		Read:   resourceTableReplicaRead,
+		Update: resourceTableReplicaUpdate,
+		Delete: resourceTableReplicaDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_secondary_index": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"read_capacity_override": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"global_table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"read_capacity_override": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceTableReplicaCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	globalTableARN, err := arn.Parse(d.Get("global_table_arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error parsing global table ARN: %w", err)
+	}
+
+	tableName := strings.TrimPrefix(globalTableARN.Resource, "table/")
+	mainRegion := globalTableARN.Region
+
+	if mainRegion == replicaRegion {
+		return fmt.Errorf("DynamoDB Table Replica (%s) must be in a different region than the global table (%s)", replicaRegion, mainRegion)
+	}
+
+	// Replicas are added and configured through the table in the main region.
+	mainConn, err := tableReplicaMainRegionConn(meta, mainRegion)
+
+	if err != nil {
+		return err
+	}
+
+	replicaInput := &dynamodb.CreateReplicationGroupMemberAction{
+		RegionName: aws.String(replicaRegion),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		replicaInput.KMSMasterKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("read_capacity_override"); ok {
+		replicaInput.ProvisionedThroughputOverride = &dynamodb.ProvisionedThroughputOverride{
+			ReadCapacityUnits: aws.Int64(int64(v.(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("global_secondary_index"); ok && v.(*schema.Set).Len() > 0 {
+		replicaInput.GlobalSecondaryIndexes = expandDynamoDbReplicaGlobalSecondaryIndexes(v.(*schema.Set).List())
+	}
+
+	input := &dynamodb.UpdateTableInput{
+		TableName: aws.String(tableName),
+		ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
+			{
+				Create: replicaInput,
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Table Replica: %s", input)
+	if err := updateDynamoDbTableReplicas(mainConn, input); err != nil {
+		return fmt.Errorf("error creating DynamoDB Table (%s) replica (%s): %w", tableName, replicaRegion, err)
+	}
+
+	d.SetId(TableReplicaCreateID(tableName, mainRegion))
+
+	if _, err := waitDynamoDBReplicaActive(mainConn, tableName, replicaRegion); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Table (%s) replica (%s) creation: %w", tableName, replicaRegion, err)
+	}
+
+	if d.Get("point_in_time_recovery").(bool) {
+		if err := updateDynamoDbTablePITR(conn, tableName, true); err != nil {
+			return fmt.Errorf("error enabling DynamoDB Table (%s) replica (%s) point in time recovery: %w", tableName, replicaRegion, err)
+		}
+	}
+
+	if len(tags) > 0 {
+		table, err := FindDynamoDBTableByName(conn, tableName)
+
+		if err != nil {
+			return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): %w", tableName, replicaRegion, err)
+		}
+
+		if table == nil {
+			return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): empty output", tableName, replicaRegion)
+		}
+
+		if err := UpdateTags(conn, aws.StringValue(table.TableArn), nil, tags); err != nil {
+			return fmt.Errorf("error adding DynamoDB Table (%s) replica (%s) tags: %w", tableName, replicaRegion, err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	tableName, mainRegion, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	mainConn, err := tableReplicaMainRegionConn(meta, mainRegion)
+
+	if err != nil {
+		return err
+	}
+
+	globalTable, err := FindDynamoDBTableByName(mainConn, tableName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing replica (%s) from state", tableName, replicaRegion)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s): %w", tableName, err)
+	}
+
+	var replica *dynamodb.ReplicaDescription
+
+	if globalTable != nil {
+		for _, v := range globalTable.Replicas {
+			if aws.StringValue(v.RegionName) == replicaRegion {
+				replica = v
+				break
+			}
+		}
+	}
+
+	if replica == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): not found after creation", tableName, replicaRegion)
+		}
+		log.Printf("[WARN] DynamoDB Table (%s) replica (%s) not found, removing from state", tableName, replicaRegion)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("global_table_arn", globalTable.TableArn)
+	d.Set("kms_key_arn", replica.KMSMasterKeyId)
+
+	// A removed override is reset to the provisioned read capacity of the table or index,
+	// so an override matching it is only read when it is already managed.
+	if v := replica.ProvisionedThroughputOverride; v != nil && (aws.Int64Value(v.ReadCapacityUnits) != tableReadCapacity(globalTable) || d.Get("read_capacity_override").(int) != 0) {
+		d.Set("read_capacity_override", v.ReadCapacityUnits)
+	} else {
+		d.Set("read_capacity_override", nil)
+	}
+
+	indexReadCapacities := tableIndexReadCapacities(globalTable)
+	managedIndexes := make(map[string]bool)
+
+	for _, tfMapRaw := range d.Get("global_secondary_index").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			managedIndexes[tfMap["name"].(string)] = true
+		}
+	}
+
+	var indexes []*dynamodb.ReplicaGlobalSecondaryIndexDescription
+
+	for _, v := range replica.GlobalSecondaryIndexes {
+		if v == nil || v.ProvisionedThroughputOverride == nil {
+			continue
+		}
+
+		name := aws.StringValue(v.IndexName)
+
+		if aws.Int64Value(v.ProvisionedThroughputOverride.ReadCapacityUnits) == indexReadCapacities[name] && !managedIndexes[name] {
+			continue
+		}
+
+		indexes = append(indexes, v)
+	}
+
+	if err := d.Set("global_secondary_index", flattenDynamoDbReplicaGlobalSecondaryIndexes(indexes)); err != nil {
+		return fmt.Errorf("error setting global_secondary_index: %w", err)
+	}
+
+	table, err := FindDynamoDBTableByName(conn, tableName)
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): %w", tableName, replicaRegion, err)
+	}
+
+	if table == nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): empty output", tableName, replicaRegion)
+	}
+
+	d.Set("arn", table.TableArn)
+
+	pitr, err := FindDynamoDBPITRDescriptionByTableName(conn, tableName)
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, "UnknownOperationException") {
+		return fmt.Errorf("error describing DynamoDB Table (%s) replica (%s) Continuous Backups: %w", tableName, replicaRegion, err)
+	}
+
+	d.Set("point_in_time_recovery", pitr != nil && aws.StringValue(pitr.PointInTimeRecoveryStatus) == dynamodb.PointInTimeRecoveryStatusEnabled)
+
+	tags, err := ListTags(conn, aws.StringValue(table.TableArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for DynamoDB Table (%s) replica (%s): %w", tableName, replicaRegion, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTableReplicaUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	tableName, mainRegion, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("global_secondary_index", "read_capacity_override") {
+		mainConn, err := tableReplicaMainRegionConn(meta, mainRegion)
+
+		if err != nil {
+			return err
+		}
+
+		replicaInput := &dynamodb.UpdateReplicationGroupMemberAction{
+			RegionName: aws.String(replicaRegion),
+		}
+
+		globalTable, err := FindDynamoDBTableByName(mainConn, tableName)
+
+		if err != nil {
+			return fmt.Errorf("error reading DynamoDB Table (%s): %w", tableName, err)
+		}
+
+		if globalTable == nil {
+			return fmt.Errorf("error reading DynamoDB Table (%s): empty output", tableName)
+		}
+
+		// Overrides can not be removed, so a removed override is reset to the
+		// provisioned read capacity of the table or index instead.
+		if v, ok := d.GetOk("read_capacity_override"); ok {
+			replicaInput.ProvisionedThroughputOverride = &dynamodb.ProvisionedThroughputOverride{
+				ReadCapacityUnits: aws.Int64(int64(v.(int))),
+			}
+		} else if v := tableReadCapacity(globalTable); d.HasChange("read_capacity_override") && v > 0 {
+			replicaInput.ProvisionedThroughputOverride = &dynamodb.ProvisionedThroughputOverride{
+				ReadCapacityUnits: aws.Int64(v),
+			}
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok && v.(*schema.Set).Len() > 0 {
+			replicaInput.GlobalSecondaryIndexes = expandDynamoDbReplicaGlobalSecondaryIndexes(v.(*schema.Set).List())
+		}
+
+		if d.HasChange("global_secondary_index") {
+			o, n := d.GetChange("global_secondary_index")
+			indexReadCapacities := tableIndexReadCapacities(globalTable)
+			configured := make(map[string]bool)
+
+			for _, tfMapRaw := range n.(*schema.Set).List() {
+				if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+					configured[tfMap["name"].(string)] = true
+				}
+			}
+
+			for _, tfMapRaw := range o.(*schema.Set).List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				name := tfMap["name"].(string)
+
+				if v := indexReadCapacities[name]; !configured[name] && v > 0 {
+					replicaInput.GlobalSecondaryIndexes = append(replicaInput.GlobalSecondaryIndexes, &dynamodb.ReplicaGlobalSecondaryIndex{
+						IndexName: aws.String(name),
+						ProvisionedThroughputOverride: &dynamodb.ProvisionedThroughputOverride{
+							ReadCapacityUnits: aws.Int64(v),
+						},
+					})
+				}
+			}
+		}
+
+		input := &dynamodb.UpdateTableInput{
+			TableName: aws.String(tableName),
+			ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
+				{
+					Update: replicaInput,
+				},
+			},
+		}
+
+		log.Printf("[DEBUG] Updating DynamoDB Table Replica: %s", input)
+		if err := updateDynamoDbTableReplicas(mainConn, input); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s): %w", tableName, replicaRegion, err)
+		}
+
+		if _, err := waitDynamoDBReplicaActive(mainConn, tableName, replicaRegion); err != nil {
+			return fmt.Errorf("error waiting for DynamoDB Table (%s) replica (%s) update: %w", tableName, replicaRegion, err)
+		}
+	}
+
+	if d.HasChange("point_in_time_recovery") {
+		if err := updateDynamoDbTablePITR(conn, tableName, d.Get("point_in_time_recovery").(bool)); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s) point in time recovery: %w", tableName, replicaRegion, err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s) tags: %w", tableName, replicaRegion, err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaDelete(d *schema.ResourceData, meta interface{}) error {
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	tableName, mainRegion, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	mainConn, err := tableReplicaMainRegionConn(meta, mainRegion)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table (%s) replica (%s)", tableName, replicaRegion)
+	err = deleteDynamoDbReplicas(tableName, []interface{}{map[string]interface{}{"region_name": replicaRegion}}, mainConn)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, "ValidationException", "Replica specified in the Replica Update or Replica Delete action of the request was not found") {
+		return nil
+	}
+
+	return err
+}
+
+// tableReplicaMainRegionConn returns a DynamoDB client for the region of the global table.
+func tableReplicaMainRegionConn(meta interface{}, region string) (*dynamodb.DynamoDB, error) {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	session, err := conns.NewSessionForRegion(&conn.Config, region, meta.(*conns.AWSClient).TerraformVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	return dynamodb.New(session), nil
+}
+
+// tableReadCapacity returns the provisioned read capacity of the table, or 0 for on-demand tables.
+func tableReadCapacity(table *dynamodb.TableDescription) int64 {
+	if table.ProvisionedThroughput == nil {
+		return 0
+	}
+
+	return aws.Int64Value(table.ProvisionedThroughput.ReadCapacityUnits)
+}
+
+// tableIndexReadCapacities returns the provisioned read capacity of each global secondary index of the table.
+func tableIndexReadCapacities(table *dynamodb.TableDescription) map[string]int64 {
+	m := make(map[string]int64)
+
+	for _, v := range table.GlobalSecondaryIndexes {
+		if v == nil || v.ProvisionedThroughput == nil {
+			continue
+		}
+
+		m[aws.StringValue(v.IndexName)] = aws.Int64Value(v.ProvisionedThroughput.ReadCapacityUnits)
+	}
+
+	return m
+}
+
+func updateDynamoDbTableReplicas(conn *dynamodb.DynamoDB, input *dynamodb.UpdateTableInput) error {
+	err := resource.Retry(replicaUpdateTimeout, func() *resource.RetryError {
+		_, err := conn.UpdateTable(input)
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, "ThrottlingException", "") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.UpdateTable(input)
+	}
+
+	return err
+}
+
+func TableReplicaCreateID(tableName, mainRegion string) string {
+	return fmt.Sprintf("%s,%s", tableName, mainRegion)
+}
+
+func TableReplicaParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected TABLE_NAME,MAIN_REGION", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandDynamoDbReplicaGlobalSecondaryIndexes(tfList []interface{}) []*dynamodb.ReplicaGlobalSecondaryIndex {
+	var apiObjects []*dynamodb.ReplicaGlobalSecondaryIndex
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &dynamodb.ReplicaGlobalSecondaryIndex{
+			IndexName: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["read_capacity_override"].(int); ok && v != 0 {
+			apiObject.ProvisionedThroughputOverride = &dynamodb.ProvisionedThroughputOverride{
+				ReadCapacityUnits: aws.Int64(int64(v)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDynamoDbReplicaGlobalSecondaryIndexes(apiObjects []*dynamodb.ReplicaGlobalSecondaryIndexDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		// Only indexes with an override are managed by this resource.
+		if apiObject == nil || apiObject.ProvisionedThroughputOverride == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                   aws.StringValue(apiObject.IndexName),
+			"read_capacity_override": aws.Int64Value(apiObject.ProvisionedThroughputOverride.ReadCapacityUnits),
+		})
+	}
+
+	return tfList
+}
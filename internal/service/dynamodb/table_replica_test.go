@@ -0,0 +1,429 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestAccDynamoDBTableReplica_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table_replica.test"
+	tableResourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckTableReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "dynamodb", fmt.Sprintf("table/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "global_table_arn", tableResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config:            testAccTableReplicaConfig_basic(rName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableReplica_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table_replica.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckTableReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdynamodb.ResourceTableReplica(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableReplica_pitr(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table_replica.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckTableReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaConfig_pitr(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery", "true"),
+				),
+			},
+			{
+				Config:            testAccTableReplicaConfig_pitr(rName, true),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableReplicaConfig_pitr(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableReplica_tags(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table_replica.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckTableReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config:            testAccTableReplicaConfig_tags1(rName, "key1", "value1"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableReplicaConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTableReplicaConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableReplica_readCapacityOverride(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table_replica.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckTableReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaConfig_readCapacityOverride(rName, 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_capacity_override", "2"),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"name":                   rName,
+						"read_capacity_override": "3",
+					}),
+				),
+			},
+			{
+				Config:            testAccTableReplicaConfig_readCapacityOverride(rName, 2, 3),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableReplicaConfig_readCapacityOverride(rName, 4, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_capacity_override", "4"),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"name":                   rName,
+						"read_capacity_override": "5",
+					}),
+				),
+			},
+			{
+				Config: testAccTableReplicaConfig_noReadCapacityOverride(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_capacity_override", "0"),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "0"),
+				),
+			},
+			{
+				Config:   testAccTableReplicaConfig_noReadCapacityOverride(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTableReplicaDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_replica" {
+			continue
+		}
+
+		tableName, _, err := tfdynamodb.TableReplicaParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfdynamodb.FindDynamoDBTableByName(conn, tableName)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("DynamoDB Table Replica %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTableReplicaExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Replica ID is set")
+		}
+
+		tableName, _, err := tfdynamodb.TableReplicaParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := tfdynamodb.FindDynamoDBTableByName(conn, tableName)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("DynamoDB Table Replica (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTableReplicaConfigBase(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  provider         = "awsalternate"
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  lifecycle {
+    ignore_changes = [replica]
+  }
+}
+`, rName))
+}
+
+func testAccTableReplicaConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTableReplicaConfigBase(rName),
+		`
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn = aws_dynamodb_table.test.arn
+}
+`)
+}
+
+func testAccTableReplicaConfig_pitr(rName string, enabled bool) string {
+	return acctest.ConfigCompose(
+		testAccTableReplicaConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn       = aws_dynamodb_table.test.arn
+  point_in_time_recovery = %[1]t
+}
+`, enabled))
+}
+
+func testAccTableReplicaConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccTableReplicaConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn = aws_dynamodb_table.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccTableReplicaConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccTableReplicaConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn = aws_dynamodb_table.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccTableReplicaConfigBaseProvisioned(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  provider         = "awsalternate"
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PROVISIONED"
+  read_capacity    = 1
+  write_capacity   = 1
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = %[1]q
+    hash_key        = "TestGSIHashKey"
+    projection_type = "KEYS_ONLY"
+    read_capacity   = 1
+    write_capacity  = 1
+  }
+
+  lifecycle {
+    ignore_changes = [replica]
+  }
+}
+`, rName))
+}
+
+func testAccTableReplicaConfig_readCapacityOverride(rName string, tableOverride, indexOverride int) string {
+	return acctest.ConfigCompose(
+		testAccTableReplicaConfigBaseProvisioned(rName),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn       = aws_dynamodb_table.test.arn
+  read_capacity_override = %[2]d
+
+  global_secondary_index {
+    name                   = %[1]q
+    read_capacity_override = %[3]d
+  }
+}
+`, rName, tableOverride, indexOverride))
+}
+
+func testAccTableReplicaConfig_noReadCapacityOverride(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTableReplicaConfigBaseProvisioned(rName),
+		`
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn = aws_dynamodb_table.test.arn
+}
+`)
+}
@@ -509,6 +509,91 @@ func TestAccDynamoDBTable_enablePITR(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_restoreFromBackup(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreFromBackupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-restored"),
+					resource.TestCheckResourceAttr(resourceName, "billing_mode", dynamodb.BillingModePayPerRequest),
+					resource.TestCheckResourceAttrPair(resourceName, "restore_backup_arn", "aws_dynamodb_backup.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restore_backup_arn"},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_restoreToLatestTime(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	sourceResourceName := "aws_dynamodb_table.source"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreToLatestTimeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-restored"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore_source_name", sourceResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "restore_to_latest_time", "true"),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restore_source_name", "restore_to_latest_time"},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_restoreValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRestoreConflictConfig(rName),
+				ExpectError: regexp.MustCompile(`"restore_date_time": conflicts with restore_to_latest_time`),
+			},
+			{
+				Config:      testAccRestoreMissingRestorePointConfig(rName),
+				ExpectError: regexp.MustCompile(`exactly one of restore_date_time or restore_to_latest_time must be set`),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_BillingMode_payPerRequestToProvisioned(t *testing.T) {
 	var conf dynamodb.DescribeTableOutput
 	resourceName := "aws_dynamodb_table.test"
@@ -1545,6 +1630,139 @@ resource "aws_dynamodb_table" "test" {
 `, rName)
 }
 
+func testAccRestoreFromBackupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "source" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_backup" "test" {
+  backup_name = %[1]q
+  table_name  = aws_dynamodb_table.source.name
+}
+
+resource "aws_dynamodb_table" "test" {
+  name               = "%[1]s-restored"
+  billing_mode       = "PAY_PER_REQUEST"
+  hash_key           = "TestTableHashKey"
+  restore_backup_arn = aws_dynamodb_backup.test.arn
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccRestoreToLatestTimeConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "source" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "TestTableGSI"
+    hash_key        = "TestGSIHashKey"
+    write_capacity  = 1
+    read_capacity   = 1
+    projection_type = "KEYS_ONLY"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+}
+
+resource "aws_dynamodb_table" "test" {
+  name                   = "%[1]s-restored"
+  read_capacity          = 1
+  write_capacity         = 1
+  hash_key               = "TestTableHashKey"
+  restore_source_name    = aws_dynamodb_table.source.name
+  restore_to_latest_time = true
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "TestTableGSI"
+    hash_key        = "TestGSIHashKey"
+    write_capacity  = 1
+    read_capacity   = 1
+    projection_type = "KEYS_ONLY"
+  }
+}
+`, rName)
+}
+
+func testAccRestoreConflictConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name                   = %[1]q
+  read_capacity          = 1
+  write_capacity         = 1
+  hash_key               = "TestTableHashKey"
+  restore_source_name    = "%[1]s-source"
+  restore_date_time      = "2021-01-01T00:00:00Z"
+  restore_to_latest_time = true
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccRestoreMissingRestorePointConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name                = %[1]q
+  read_capacity       = 1
+  write_capacity      = 1
+  hash_key            = "TestTableHashKey"
+  restore_source_name = "%[1]s-source"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+`, rName)
+}
+
 func testAccBilling_payPerRequest(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
@@ -36,6 +36,26 @@ func validStreamSpec(d *schema.ResourceDiff) error {
 	return nil
 }
 
+// validTableRestore checks that a point in time restore has exactly one restore point.
+func validTableRestore(d *schema.ResourceDiff) error {
+	if v, ok := d.GetOk("restore_source_name"); !ok || v.(string) == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("restore_date_time") || !d.NewValueKnown("restore_to_latest_time") {
+		return nil
+	}
+
+	_, restoreDateTime := d.GetOk("restore_date_time")
+	restoreToLatestTime := d.Get("restore_to_latest_time").(bool)
+
+	if restoreDateTime == restoreToLatestTime {
+		return errors.New("exactly one of restore_date_time or restore_to_latest_time must be set when restore_source_name is set")
+	}
+
+	return nil
+}
+
 // checkIfNonKeyAttributesChanged returns true if non_key_attributes between old map and new map are different
 func checkIfNonKeyAttributesChanged(oldMap, newMap map[string]interface{}) bool {
 	oldNonKeyAttributes, oldNkaExists := oldMap["non_key_attributes"].(*schema.Set)
@@ -2,13 +2,20 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
+	backupAvailableTimeout                     = 10 * time.Minute
+	backupDeletedTimeout                       = 10 * time.Minute
+	contributorInsightsEnabledTimeout          = 5 * time.Minute
+	contributorInsightsDisabledTimeout         = 5 * time.Minute
 	kinesisStreamingDestinationActiveTimeout   = 5 * time.Minute
 	kinesisStreamingDestinationDisabledTimeout = 5 * time.Minute
 	createTableTimeout                         = 20 * time.Minute
@@ -21,6 +28,40 @@ const (
 	ttlUpdateTimeout                           = 30 * time.Second
 )
 
+func waitDynamoDBBackupAvailable(ctx context.Context, conn *dynamodb.DynamoDB, arn string) (*dynamodb.BackupDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.BackupStatusCreating},
+		Target:  []string{dynamodb.BackupStatusAvailable},
+		Timeout: backupAvailableTimeout,
+		Refresh: statusDynamoDBBackup(ctx, conn, arn),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.BackupDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDynamoDBBackupDeleted(ctx context.Context, conn *dynamodb.DynamoDB, arn string) (*dynamodb.BackupDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.BackupStatusAvailable, dynamodb.BackupStatusCreating},
+		Target:  []string{dynamodb.BackupStatusDeleted},
+		Timeout: backupDeletedTimeout,
+		Refresh: statusDynamoDBBackup(ctx, conn, arn),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.BackupDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDynamoDBKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.DynamoDB, streamArn, tableName string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{dynamodb.DestinationStatusDisabled, dynamodb.DestinationStatusEnabling},
@@ -69,6 +110,28 @@ func waitDynamoDBTableActive(conn *dynamodb.DynamoDB, tableName string) (*dynamo
 	return nil, err
 }
 
+func waitDynamoDBTableRestored(conn *dynamodb.DynamoDB, tableName string, timeout time.Duration) (*dynamodb.TableDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.TableStatusCreating,
+			dynamodb.TableStatusUpdating,
+		},
+		Target: []string{
+			dynamodb.TableStatusActive,
+		},
+		Timeout: timeout,
+		Refresh: statusDynamoDBTable(conn, tableName),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDynamoDBTableDeleted(conn *dynamodb.DynamoDB, tableName string) (*dynamodb.TableDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -258,3 +321,63 @@ func waitDynamoDBSSEUpdated(conn *dynamodb.DynamoDB, tableName string) (*dynamod
 
 	return nil, err
 }
+
+func waitDynamoDBContributorInsightsEnabled(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ContributorInsightsStatusEnabling},
+		Target:  []string{dynamodb.ContributorInsightsStatusEnabled},
+		Timeout: contributorInsightsEnabledTimeout,
+		Refresh: statusDynamoDBContributorInsights(ctx, conn, tableName, indexName),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		if output.FailureException != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureException.ExceptionName), aws.StringValue(output.FailureException.ExceptionDescription)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDynamoDBContributorInsightsDisabled(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ContributorInsightsStatusDisabling, dynamodb.ContributorInsightsStatusEnabled},
+		Target:  []string{dynamodb.ContributorInsightsStatusDisabled},
+		Timeout: contributorInsightsDisabledTimeout,
+		Refresh: statusDynamoDBContributorInsights(ctx, conn, tableName, indexName),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDynamoDBTableExportCompleted(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: timeout,
+		Refresh: statusDynamoDBTableExport(ctx, conn, arn),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if aws.StringValue(output.ExportStatus) == dynamodb.ExportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,138 @@
+package ec2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceAccountAttributes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAccountAttributesRead,
+
+		Schema: map[string]*schema.Schema{
+			"default_vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ebs_default_kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ebs_encryption_by_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"max_elastic_ips": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_instances": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"serial_console_access_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"supported_platforms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_max_elastic_ips": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vpc_max_security_groups_per_interface": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAccountAttributesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	output, err := conn.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Account Attributes: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	for _, attribute := range output.AccountAttributes {
+		if attribute == nil {
+			continue
+		}
+
+		var values []string
+		for _, v := range attribute.AttributeValues {
+			if v == nil {
+				continue
+			}
+
+			values = append(values, aws.StringValue(v.AttributeValue))
+		}
+
+		switch name := aws.StringValue(attribute.AttributeName); name {
+		case ec2.AccountAttributeNameDefaultVpc:
+			if len(values) > 0 && values[0] != "none" {
+				d.Set("default_vpc_id", values[0])
+			}
+		case ec2.AccountAttributeNameSupportedPlatforms:
+			d.Set("supported_platforms", values)
+		case "max-elastic-ips", "max-instances", "vpc-max-elastic-ips", "vpc-max-security-groups-per-interface":
+			if len(values) == 0 {
+				continue
+			}
+
+			v, err := strconv.Atoi(values[0])
+
+			if err != nil {
+				return fmt.Errorf("error parsing EC2 Account Attribute (%s) value (%s): %w", name, values[0], err)
+			}
+
+			d.Set(accountAttributeNameToSchemaKey(name), v)
+		}
+	}
+
+	ebsEncryption, err := conn.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading EBS encryption by default: %w", err)
+	}
+
+	d.Set("ebs_encryption_by_default", ebsEncryption.EbsEncryptionByDefault)
+
+	ebsKMSKey, err := conn.GetEbsDefaultKmsKeyId(&ec2.GetEbsDefaultKmsKeyIdInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading EBS default KMS key: %w", err)
+	}
+
+	d.Set("ebs_default_kms_key_id", ebsKMSKey.KmsKeyId)
+
+	serialConsole, err := conn.GetSerialConsoleAccessStatus(&ec2.GetSerialConsoleAccessStatusInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Serial Console Access: %w", err)
+	}
+
+	d.Set("serial_console_access_enabled", serialConsole.SerialConsoleAccessEnabled)
+
+	return nil
+}
+
+// accountAttributeNameToSchemaKey converts an EC2 account attribute name, e.g. "max-instances",
+// to the corresponding schema key, e.g. "max_instances".
+func accountAttributeNameToSchemaKey(name string) string {
+	return strings.ReplaceAll(name, "-", "_")
+}
@@ -0,0 +1,42 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2AccountAttributesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_account_attributes.current"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAttributesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "ebs_encryption_by_default", "data.aws_ebs_encryption_by_default.current", "enabled"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ebs_default_kms_key_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_elastic_ips"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_instances"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "serial_console_access_enabled", "data.aws_ec2_serial_console_access.current", "enabled"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "supported_platforms.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpc_max_elastic_ips"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpc_max_security_groups_per_interface"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAccountAttributesDataSourceConfig = `
+data "aws_ec2_account_attributes" "current" {}
+
+data "aws_ebs_encryption_by_default" "current" {}
+
+data "aws_ec2_serial_console_access" "current" {}
+`
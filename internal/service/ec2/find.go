@@ -1311,3 +1311,41 @@ func FindPlacementGroupByName(conn *ec2.EC2, name string) (*ec2.PlacementGroup,
 
 	return placementGroup, nil
 }
+
+func FindSubnetCIDRReservationBySubnetIDAndReservationID(conn *ec2.EC2, subnetID, reservationID string) (*ec2.SubnetCidrReservation, error) {
+	input := &ec2.GetSubnetCidrReservationsInput{
+		SubnetId: aws.String(subnetID),
+	}
+
+	output, err := conn.GetSubnetCidrReservations(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidSubnetIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || (len(output.SubnetIpv4CidrReservations) == 0 && len(output.SubnetIpv6CidrReservations) == 0) {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, r := range output.SubnetIpv4CidrReservations {
+		if aws.StringValue(r.SubnetCidrReservationId) == reservationID {
+			return r, nil
+		}
+	}
+	for _, r := range output.SubnetIpv6CidrReservations {
+		if aws.StringValue(r.SubnetCidrReservationId) == reservationID {
+			return r, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -32,12 +34,17 @@ func ResourceFlowLog() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deliver_cross_account_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"destination_options": {
-				Type:             schema.TypeList,
-				Optional:         true,
-				ForceNew:         true,
-				MaxItems:         1,
-				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"file_format": {
@@ -90,10 +97,11 @@ func ResourceFlowLog() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(ec2.LogDestinationType_Values(), false),
 			},
 			"log_format": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validFlowLogFormat,
 			},
 			"log_group_name": {
 				Type:          schema.TypeString,
@@ -132,7 +140,10 @@ func ResourceFlowLog() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceFlowLogCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -174,6 +185,10 @@ func resourceLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 		TrafficType:        aws.String(d.Get("traffic_type").(string)),
 	}
 
+	if v, ok := d.GetOk("deliver_cross_account_role"); ok {
+		input.DeliverCrossAccountRole = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("destination_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.DestinationOptions = expandEc2DestinationOptionsRequest(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -243,12 +258,19 @@ func resourceLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 		Resource:  fmt.Sprintf("vpc-flow-log/%s", d.Id()),
 	}.String()
 	d.Set("arn", arn)
-	if fl.DestinationOptions != nil {
-		if err := d.Set("destination_options", []interface{}{flattenEc2DestinationOptionsResponse(fl.DestinationOptions)}); err != nil {
-			return fmt.Errorf("error setting destination_options: %w", err)
+	d.Set("deliver_cross_account_role", fl.DeliverCrossAccountRole)
+	var destinationOptions []interface{}
+
+	// Flow logs delivered to S3 always report destination options, so the defaults
+	// are only kept when they are already configured.
+	if v := fl.DestinationOptions; v != nil {
+		if _, ok := d.GetOk("destination_options"); ok || !isDefaultEc2DestinationOptionsResponse(v) {
+			destinationOptions = []interface{}{flattenEc2DestinationOptionsResponse(v)}
 		}
-	} else {
-		d.Set("destination_options", nil)
+	}
+
+	if err := d.Set("destination_options", destinationOptions); err != nil {
+		return fmt.Errorf("error setting destination_options: %w", err)
 	}
 	d.Set("iam_role_arn", fl.DeliverLogsPermissionArn)
 	d.Set("log_destination", fl.LogDestination)
@@ -317,6 +339,20 @@ func resourceLogFlowDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceFlowLogCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	logDestinationType := diff.Get("log_destination_type").(string)
+
+	if v, ok := diff.GetOk("destination_options"); ok && len(v.([]interface{})) > 0 && logDestinationType != ec2.LogDestinationTypeS3 {
+		return fmt.Errorf("destination_options can only be specified when log_destination_type is %q", ec2.LogDestinationTypeS3)
+	}
+
+	if v, ok := diff.GetOk("deliver_cross_account_role"); ok && v.(string) != "" && logDestinationType != ec2.LogDestinationTypeKinesisDataFirehose {
+		return fmt.Errorf("deliver_cross_account_role can only be specified when log_destination_type is %q", ec2.LogDestinationTypeKinesisDataFirehose)
+	}
+
+	return nil
+}
+
 func expandEc2DestinationOptionsRequest(tfMap map[string]interface{}) *ec2.DestinationOptionsRequest {
 	if tfMap == nil {
 		return nil
@@ -339,6 +375,10 @@ func expandEc2DestinationOptionsRequest(tfMap map[string]interface{}) *ec2.Desti
 	return apiObject
 }
 
+func isDefaultEc2DestinationOptionsResponse(apiObject *ec2.DestinationOptionsResponse) bool {
+	return aws.StringValue(apiObject.FileFormat) == ec2.DestinationFileFormatPlainText && !aws.BoolValue(apiObject.HiveCompatiblePartitions) && !aws.BoolValue(apiObject.PerHourPartition)
+}
+
 func flattenEc2DestinationOptionsResponse(apiObject *ec2.DestinationOptionsResponse) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -88,6 +89,23 @@ func TestAccEC2FlowLog_logFormat(t *testing.T) {
 	})
 }
 
+func TestAccEC2FlowLog_logFormatInvalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFlowLogConfig_LogFormatCustom(rName, "$${version} $${not-a-field}"),
+				ExpectError: regexp.MustCompile(`unsupported field "not-a-field"`),
+			},
+		},
+	})
+}
+
 func TestAccEC2FlowLog_subnetID(t *testing.T) {
 	var flowLog ec2.FlowLog
 	cloudwatchLogGroupResourceName := "aws_cloudwatch_log_group.test"
@@ -185,6 +203,90 @@ func TestAccEC2FlowLog_LogDestinationType_s3(t *testing.T) {
 	})
 }
 
+func TestAccEC2FlowLog_LogDestinationType_kinesisFirehose(t *testing.T) {
+	var flowLog ec2.FlowLog
+	kinesisFirehoseResourceName := "aws_kinesis_firehose_delivery_stream.test"
+	resourceName := "aws_flow_log.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowLogConfig_LogDestinationType_KinesisFirehose(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowLogExists(resourceName, &flowLog),
+					resource.TestCheckResourceAttr(resourceName, "deliver_cross_account_role", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "log_destination", kinesisFirehoseResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "log_destination_type", "kinesis-data-firehose"),
+					resource.TestCheckResourceAttr(resourceName, "log_group_name", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2FlowLog_LogDestinationTypeKinesisFirehose_crossAccount(t *testing.T) {
+	var providers []*schema.Provider
+	var flowLog ec2.FlowLog
+	iamRoleResourceName := "aws_iam_role.source"
+	kinesisFirehoseResourceName := "aws_kinesis_firehose_delivery_stream.test"
+	resourceName := "aws_flow_log.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// The role in the destination account has a fixed name, so this test can not run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowLogConfig_LogDestinationType_KinesisFirehose_CrossAccount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowLogExists(resourceName, &flowLog),
+					resource.TestCheckResourceAttrPair(resourceName, "deliver_cross_account_role", iamRoleResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_destination", kinesisFirehoseResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "log_destination_type", "kinesis-data-firehose"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2FlowLog_LogDestinationType_invalidDestinationOptions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFlowLogConfig_LogDestinationType_CloudWatchLogs_DO(rName),
+				ExpectError: regexp.MustCompile(`destination_options can only be specified when log_destination_type is "s3"`),
+			},
+		},
+	})
+}
+
 func TestAccEC2FlowLog_LogDestinationTypeS3_invalid(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test-flow-log-s3-invalid")
 
@@ -293,6 +395,13 @@ func TestAccEC2FlowLog_LogDestinationTypeS3DO_parquet(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccFlowLogConfig_LogDestinationType_S3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowLogExists(resourceName, &flowLog),
+					resource.TestCheckResourceAttr(resourceName, "destination_options.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -575,6 +684,303 @@ resource "aws_flow_log" "test" {
 `, rName)
 }
 
+func testAccFlowLogConfig_LogDestinationType_KinesisFirehose(rName string) string {
+	return testAccFlowLogConfigBase(rName) + fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "firehose.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:AbortMultipartUpload",
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:ListBucketMultipartUploads",
+        "s3:PutObject"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  name        = %[1]q
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.test.arn
+    bucket_arn = aws_s3_bucket.test.arn
+  }
+
+  tags = {
+    "LogDeliveryEnabled" = "true"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_flow_log" "test" {
+  log_destination      = aws_kinesis_firehose_delivery_stream.test.arn
+  log_destination_type = "kinesis-data-firehose"
+  traffic_type         = "ALL"
+  vpc_id               = aws_vpc.test.id
+}
+`, rName)
+}
+
+func testAccFlowLogConfig_LogDestinationType_KinesisFirehose_CrossAccount(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), testAccFlowLogConfigBase(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+# The Kinesis Data Firehose delivery stream is in the destination (alternate) account.
+resource "aws_s3_bucket" "test" {
+  provider = "awsalternate"
+
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  provider = "awsalternate"
+
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "firehose.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  provider = "awsalternate"
+
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:AbortMultipartUpload",
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:ListBucketMultipartUploads",
+        "s3:PutObject"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  provider = "awsalternate"
+
+  name        = %[1]q
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.test.arn
+    bucket_arn = aws_s3_bucket.test.arn
+  }
+
+  tags = {
+    "LogDeliveryEnabled" = "true"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+# The role in the destination account is assumed by the role in the source account.
+# Flow logs require this fixed role name.
+resource "aws_iam_role" "destination" {
+  provider = "awsalternate"
+
+  name = "AWSLogDeliveryFirehoseCrossAccountRole"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "AWS": "${aws_iam_role.source.arn}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "destination" {
+  provider = "awsalternate"
+
+  name = "%[1]s-destination"
+  role = aws_iam_role.destination.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "iam:CreateServiceLinkedRole",
+        "firehose:TagDeliveryStream"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+# The role in the source account is used by the flow log to deliver across accounts.
+resource "aws_iam_role" "source" {
+  name = "%[1]s-source"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "delivery.logs.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "source" {
+  name = "%[1]s-source"
+  role = aws_iam_role.source.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "iam:PassRole",
+      "Resource": "${aws_iam_role.source.arn}"
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogDelivery",
+        "logs:DeleteLogDelivery",
+        "logs:ListLogDeliveries",
+        "logs:GetLogDelivery"
+      ],
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "sts:AssumeRole",
+      "Resource": "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.alternate.account_id}:role/${aws_iam_role.destination.name}"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_flow_log" "test" {
+  deliver_cross_account_role = aws_iam_role.source.arn
+  log_destination            = aws_kinesis_firehose_delivery_stream.test.arn
+  log_destination_type       = "kinesis-data-firehose"
+  traffic_type               = "ALL"
+  vpc_id                     = aws_vpc.test.id
+
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.destination]
+}
+`, rName))
+}
+
+func testAccFlowLogConfig_LogDestinationType_CloudWatchLogs_DO(rName string) string {
+	return testAccFlowLogConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_flow_log" "test" {
+  log_destination      = aws_cloudwatch_log_group.test.arn
+  log_destination_type = "cloud-watch-logs"
+  traffic_type         = "ALL"
+  vpc_id               = aws_vpc.test.id
+
+  destination_options {
+    file_format = "parquet"
+  }
+}
+`, rName)
+}
+
 func testAccFlowLogConfig_LogDestinationType_S3_Invalid(rName string) string {
 	return testAccFlowLogConfigBase(rName) + `
 data "aws_partition" "current" {}
@@ -953,3 +1359,20 @@ resource "aws_flow_log" "test" {
 }
 `, rName)
 }
+
+func testAccFlowLogConfig_LogFormatCustom(rName, logFormat string) string {
+	return testAccFlowLogConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_flow_log" "test" {
+  log_destination      = aws_s3_bucket.test.arn
+  log_destination_type = "s3"
+  log_format           = %[2]q
+  traffic_type         = "ALL"
+  vpc_id               = aws_vpc.test.id
+}
+`, rName, logFormat)
+}
@@ -0,0 +1,177 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceInstanceState() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceStateCreate,
+		Read:   resourceInstanceStateRead,
+		Update: resourceInstanceStateUpdate,
+		Delete: resourceInstanceStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceInstanceStateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.InstanceStateNameRunning,
+					ec2.InstanceStateNameStopped,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceInstanceStateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	id := d.Get("instance_id").(string)
+
+	instance, err := WaitInstanceReadyForStateChange(conn, id, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) to be ready: %w", id, err)
+	}
+
+	if err := updateInstanceState(conn, id, aws.StringValue(instance.State.Name), d.Get("state").(string), d.Get("force").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := FindInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeInvalidInstanceIDNotFound) {
+		log.Printf("[WARN] EC2 Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance (%s): %w", d.Id(), err)
+	}
+
+	if instance == nil || instance.State == nil || aws.StringValue(instance.State.Name) == ec2.InstanceStateNameTerminated {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading EC2 Instance (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] EC2 Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instance.InstanceId)
+	d.Set("state", instance.State.Name)
+
+	return nil
+}
+
+func resourceInstanceStateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := WaitInstanceReadyForStateChange(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) to be ready: %w", d.Id(), err)
+	}
+
+	if d.HasChange("state") {
+		if err := updateInstanceState(conn, d.Id(), aws.StringValue(instance.State.Name), d.Get("state").(string), d.Get("force").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateDelete(d *schema.ResourceData, meta interface{}) error {
+	// The instance is left in its current state.
+	log.Printf("[DEBUG] Removing EC2 Instance (%s) state from Terraform state; the instance is not modified", d.Id())
+
+	return nil
+}
+
+func resourceInstanceStateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("force", false)
+	d.Set("instance_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateInstanceState starts or stops the instance so that it reaches the
+// desired state. Instances already in the desired state are left untouched.
+func updateInstanceState(conn *ec2.EC2, id string, currentState string, configuredState string, force bool, timeout time.Duration) error {
+	if currentState == configuredState {
+		return nil
+	}
+
+	switch configuredState {
+	case ec2.InstanceStateNameRunning:
+		log.Printf("[DEBUG] Starting EC2 Instance (%s)", id)
+		_, err := conn.StartInstances(&ec2.StartInstancesInput{
+			InstanceIds: aws.StringSlice([]string{id}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error starting EC2 Instance (%s): %w", id, err)
+		}
+
+		if _, err := WaitInstanceStarted(conn, id, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Instance (%s) to start: %w", id, err)
+		}
+	case ec2.InstanceStateNameStopped:
+		log.Printf("[DEBUG] Stopping EC2 Instance (%s), force: %t", id, force)
+		_, err := conn.StopInstances(&ec2.StopInstancesInput{
+			Force:       aws.Bool(force),
+			InstanceIds: aws.StringSlice([]string{id}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error stopping EC2 Instance (%s): %w", id, err)
+		}
+
+		if _, err := WaitInstanceStopped(conn, id, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Instance (%s) to stop: %w", id, err)
+		}
+	default:
+		return fmt.Errorf("unsupported EC2 Instance (%s) state: %s", id, configuredState)
+	}
+
+	return nil
+}
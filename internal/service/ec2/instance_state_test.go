@@ -0,0 +1,162 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2InstanceState_basic(t *testing.T) {
+	resourceName := "aws_ec2_instance_state.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStateConfig(ec2.InstanceStateNameStopped, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(resourceName, ec2.InstanceStateNameStopped),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.InstanceStateNameStopped),
+					resource.TestCheckResourceAttr(resourceName, "force", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceStateConfig(ec2.InstanceStateNameRunning, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(resourceName, ec2.InstanceStateNameRunning),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.InstanceStateNameRunning),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceState_force(t *testing.T) {
+	resourceName := "aws_ec2_instance_state.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStateConfig(ec2.InstanceStateNameStopped, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(resourceName, ec2.InstanceStateNameStopped),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.InstanceStateNameStopped),
+					resource.TestCheckResourceAttr(resourceName, "force", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceState_drift(t *testing.T) {
+	resourceName := "aws_ec2_instance_state.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStateConfig(ec2.InstanceStateNameStopped, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(resourceName, ec2.InstanceStateNameStopped),
+					testAccCheckInstanceStateStartInstance(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceStateExists(n string, expectedState string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Instance ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		instance, err := tfec2.FindInstanceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if instance == nil || instance.State == nil {
+			return fmt.Errorf("EC2 Instance (%s) not found", rs.Primary.ID)
+		}
+
+		if state := aws.StringValue(instance.State.Name); state != expectedState {
+			return fmt.Errorf("EC2 Instance (%s) state is %s, expected %s", rs.Primary.ID, state, expectedState)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInstanceStateStartInstance(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := conn.StartInstances(&ec2.StartInstancesInput{
+			InstanceIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error starting EC2 Instance (%s): %w", rs.Primary.ID, err)
+		}
+
+		_, err = tfec2.WaitInstanceStarted(conn, rs.Primary.ID, tfec2.InstanceStopTimeout)
+
+		return err
+	}
+}
+
+func testAccInstanceStateConfig(state string, force bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHvmEbsAmi(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+}
+
+resource "aws_ec2_instance_state" "test" {
+  instance_id = aws_instance.test.id
+  state       = %[1]q
+  force       = %[2]t
+}
+`, state, force))
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -59,13 +60,40 @@ func ResourceKeyPair() *schema.Resource {
 				ValidateFunc:  validation.StringLenBetween(0, 255-resource.UniqueIDSuffixLength),
 				ConflictsWith: []string{"key_name"},
 			},
+			"encrypted_private_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"key_pair_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"key_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice(ec2.KeyType_Values(), false),
+				ConflictsWith: []string{"public_key"},
+			},
+			"pgp_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"public_key"},
+			},
+			"pgp_key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"public_key": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				StateFunc: func(v interface{}) string {
 					switch v := v.(type) {
@@ -89,19 +117,58 @@ func resourceKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
 
 	keyName := create.Name(d.Get("key_name").(string), d.Get("key_name_prefix").(string))
 
-	input := &ec2.ImportKeyPairInput{
-		KeyName:           aws.String(keyName),
-		PublicKeyMaterial: []byte(d.Get("public_key").(string)),
-		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeKeyPair),
-	}
+	// Without a public key, EC2 generates the key pair and returns the private key material once.
+	if v, ok := d.GetOk("public_key"); ok {
+		input := &ec2.ImportKeyPairInput{
+			KeyName:           aws.String(keyName),
+			PublicKeyMaterial: []byte(v.(string)),
+			TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeKeyPair),
+		}
 
-	output, err := conn.ImportKeyPair(input)
+		output, err := conn.ImportKeyPair(input)
 
-	if err != nil {
-		return fmt.Errorf("error importing EC2 Key Pair (%s): %w", keyName, err)
-	}
+		if err != nil {
+			return fmt.Errorf("error importing EC2 Key Pair (%s): %w", keyName, err)
+		}
+
+		d.SetId(aws.StringValue(output.KeyName))
+	} else {
+		input := &ec2.CreateKeyPairInput{
+			KeyName:           aws.String(keyName),
+			TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeKeyPair),
+		}
 
-	d.SetId(aws.StringValue(output.KeyName))
+		if v, ok := d.GetOk("key_type"); ok {
+			input.KeyType = aws.String(v.(string))
+		}
+
+		output, err := conn.CreateKeyPair(input)
+
+		if err != nil {
+			return fmt.Errorf("error creating EC2 Key Pair (%s): %w", keyName, err)
+		}
+
+		d.SetId(aws.StringValue(output.KeyName))
+
+		if v, ok := d.GetOk("pgp_key"); ok {
+			encryptionKey, err := tfiam.RetrieveGPGKey(v.(string))
+
+			if err != nil {
+				return err
+			}
+
+			fingerprint, encrypted, err := tfiam.EncryptValue(encryptionKey, aws.StringValue(output.KeyMaterial), "EC2 Key Pair private key")
+
+			if err != nil {
+				return err
+			}
+
+			d.Set("encrypted_private_key", encrypted)
+			d.Set("pgp_key_fingerprint", fingerprint)
+		} else {
+			d.Set("private_key", output.KeyMaterial)
+		}
+	}
 
 	return resourceKeyPairRead(d, meta)
 }
@@ -135,6 +202,7 @@ func resourceKeyPairRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("key_name", keyPair.KeyName)
 	d.Set("key_name_prefix", create.NamePrefixFromName(aws.StringValue(keyPair.KeyName)))
 	d.Set("key_pair_id", keyPair.KeyPairId)
+	d.Set("key_type", keyPair.KeyType)
 
 	tags := KeyValueTags(keyPair.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -34,6 +34,10 @@ func DataSourceKeyPair() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"key_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
@@ -77,6 +81,7 @@ func dataSourceKeyPairRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("fingerprint", keyPair.KeyFingerprint)
 	d.Set("key_name", keyName)
 	d.Set("key_pair_id", keyPair.KeyPairId)
+	d.Set("key_type", keyPair.KeyType)
 
 	if err := d.Set("tags", KeyValueTags(keyPair.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
@@ -34,18 +34,21 @@ func TestAccEC2KeyPairDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSource1Name, "fingerprint", resourceName, "fingerprint"),
 					resource.TestCheckResourceAttrPair(dataSource1Name, "key_name", resourceName, "key_name"),
 					resource.TestCheckResourceAttrPair(dataSource1Name, "key_pair_id", resourceName, "key_pair_id"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "key_type", resourceName, "key_type"),
 					resource.TestCheckResourceAttrPair(dataSource1Name, "tags.%", resourceName, "tags.%"),
 
 					resource.TestCheckResourceAttrPair(dataSource2Name, "arn", resourceName, "arn"),
 					resource.TestCheckResourceAttrPair(dataSource2Name, "fingerprint", resourceName, "fingerprint"),
 					resource.TestCheckResourceAttrPair(dataSource2Name, "key_name", resourceName, "key_name"),
 					resource.TestCheckResourceAttrPair(dataSource2Name, "key_pair_id", resourceName, "key_pair_id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "key_type", resourceName, "key_type"),
 					resource.TestCheckResourceAttrPair(dataSource2Name, "tags.%", resourceName, "tags.%"),
 
 					resource.TestCheckResourceAttrPair(dataSource3Name, "arn", resourceName, "arn"),
 					resource.TestCheckResourceAttrPair(dataSource3Name, "fingerprint", resourceName, "fingerprint"),
 					resource.TestCheckResourceAttrPair(dataSource3Name, "key_name", resourceName, "key_name"),
 					resource.TestCheckResourceAttrPair(dataSource3Name, "key_pair_id", resourceName, "key_pair_id"),
+					resource.TestCheckResourceAttrPair(dataSource3Name, "key_type", resourceName, "key_type"),
 					resource.TestCheckResourceAttrPair(dataSource3Name, "tags.%", resourceName, "tags.%"),
 				),
 			},
@@ -244,6 +244,27 @@ func StatusInstanceIAMInstanceProfile(conn *ec2.EC2, id string) resource.StateRe
 	}
 }
 
+// StatusInstanceState fetches the Instance and its State
+func StatusInstanceState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := FindInstanceByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, ErrCodeInvalidInstanceIDNotFound) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if instance == nil || instance.State == nil {
+			return nil, "", nil
+		}
+
+		return instance, aws.StringValue(instance.State.Name), nil
+	}
+}
+
 const (
 	RouteStatusReady = "ready"
 )
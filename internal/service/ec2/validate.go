@@ -79,3 +79,72 @@ func valid4ByteASN(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+// https://docs.aws.amazon.com/vpc/latest/userguide/flow-logs.html#flow-logs-fields
+var flowLogFields = []string{
+	"account-id",
+	"action",
+	"az-id",
+	"bytes",
+	"dstaddr",
+	"dstport",
+	"end",
+	"flow-direction",
+	"instance-id",
+	"interface-id",
+	"log-status",
+	"packets",
+	"pkt-dst-aws-service",
+	"pkt-dstaddr",
+	"pkt-src-aws-service",
+	"pkt-srcaddr",
+	"protocol",
+	"region",
+	"srcaddr",
+	"srcport",
+	"start",
+	"sublocation-id",
+	"sublocation-type",
+	"subnet-id",
+	"tcp-flags",
+	"traffic-path",
+	"type",
+	"version",
+	"vpc-id",
+}
+
+var flowLogFieldRegexp = regexp.MustCompile(`^\$\{([a-z0-9-]+)\}$`)
+
+// validFlowLogFormat validates that a custom flow log format consists of
+// space-separated ${field-name} references to supported flow log fields.
+func validFlowLogFormat(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one field", k))
+		return
+	}
+
+	for _, field := range fields {
+		m := flowLogFieldRegexp.FindStringSubmatch(field)
+		if m == nil {
+			errors = append(errors, fmt.Errorf("%q contains invalid field %q, fields must be in the form ${field-name}", k, field))
+			continue
+		}
+
+		valid := false
+		for _, name := range flowLogFields {
+			if m[1] == name {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			errors = append(errors, fmt.Errorf("%q contains unsupported field %q, supported fields are: %s", k, m[1], strings.Join(flowLogFields, ", ")))
+		}
+	}
+
+	return
+}
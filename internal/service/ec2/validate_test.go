@@ -105,3 +105,33 @@ func TestValid4ByteASN(t *testing.T) {
 		}
 	}
 }
+
+func TestValidFlowLogFormat(t *testing.T) {
+	validFormats := []string{
+		"${version} ${account-id} ${interface-id} ${srcaddr} ${dstaddr} ${srcport} ${dstport} ${protocol} ${packets} ${bytes} ${start} ${end} ${action} ${log-status}",
+		"${vpc-id} ${subnet-id} ${instance-id} ${tcp-flags} ${type} ${pkt-srcaddr} ${pkt-dstaddr}",
+		"${region} ${az-id} ${sublocation-type} ${sublocation-id} ${pkt-src-aws-service} ${pkt-dst-aws-service} ${flow-direction} ${traffic-path}",
+		"${srcaddr}",
+	}
+	for _, v := range validFormats {
+		_, errors := validFlowLogFormat(v, "log_format")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid flow log format: %q", v, errors)
+		}
+	}
+
+	invalidFormats := []string{
+		"",
+		"   ",
+		"${srcaddr} ${not-a-field}",
+		"srcaddr dstaddr",
+		"${srcaddr},${dstaddr}",
+		"$srcaddr",
+	}
+	for _, v := range invalidFormats {
+		_, errors := validFlowLogFormat(v, "log_format")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid flow log format", v)
+		}
+	}
+}
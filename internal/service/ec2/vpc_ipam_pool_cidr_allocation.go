@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -40,6 +41,18 @@ func ResourceVPCIpamPoolCidrAllocation() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"disallowed_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.Any(
+						verify.ValidIPv4CIDRNetworkAddress,
+						validation.IsCIDRNetwork(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
+					),
+				},
+			},
 			"ipam_pool_allocation_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -97,6 +110,10 @@ func ResourceVPCIpamPoolCidrAllocationCreate(d *schema.ResourceData, meta interf
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("disallowed_cidrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.DisallowedCidrs = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	log.Printf("[DEBUG] Creating IPAM Pool Allocation: %s", input)
 	output, err := conn.AllocateIpamPoolCidr(input)
 	if err != nil {
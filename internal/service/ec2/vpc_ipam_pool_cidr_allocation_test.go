@@ -74,6 +74,43 @@ func TestAccVPCIpamPoolAllocation_ipv4BasicNetmask(t *testing.T) {
 	})
 }
 
+func TestAccVPCIpamPoolAllocation_ipv4DisallowedCidr(t *testing.T) {
+	var allocation ec2.IpamPoolAllocation
+	resourceName := "aws_vpc_ipam_pool_cidr_allocation.test"
+	disallowedCidr := "172.2.0.0/28"
+	netmask := "28"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIpamPoolAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIpamPoolAllocationIpv4DisallowedCidr(netmask, disallowedCidr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIpamAllocationExists(resourceName, &allocation),
+					testAccCheckVPCIpamCidrPrefix(&allocation, netmask),
+					resource.TestCheckResourceAttr(resourceName, "disallowed_cidrs.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "disallowed_cidrs.*", disallowedCidr),
+					func(s *terraform.State) error {
+						if aws.StringValue(allocation.Cidr) == disallowedCidr {
+							return fmt.Errorf("IPAM Pool Allocation (%s) allocated disallowed CIDR %s", aws.StringValue(allocation.IpamPoolAllocationId), disallowedCidr)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disallowed_cidrs", "netmask_length"},
+			},
+		},
+	})
+}
+
 func testAccCheckVPCIpamAllocationExists(n string, allocation *ec2.IpamPoolAllocation) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -172,3 +209,16 @@ resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
 }
 `, netmask)
 }
+
+func testAccVPCIpamPoolAllocationIpv4DisallowedCidr(netmask, disallowedCidr string) string {
+	return testAccVPCIpamPoolCidrPrivateBase + fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  ipam_pool_id     = aws_vpc_ipam_pool.test.id
+  netmask_length   = %[1]q
+  disallowed_cidrs = [%[2]q]
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+`, netmask, disallowedCidr)
+}
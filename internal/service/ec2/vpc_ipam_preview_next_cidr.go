@@ -0,0 +1,116 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCIpamPreviewNextCidr() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCIpamPreviewNextCidrCreate,
+		Read:   resourceVPCIpamPreviewNextCidrRead,
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disallowed_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.Any(
+						verify.ValidIPv4CIDRNetworkAddress,
+						validation.IsCIDRNetwork(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
+					),
+				},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
+			},
+		},
+	}
+}
+
+func resourceVPCIpamPreviewNextCidrCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	poolId := d.Get("ipam_pool_id").(string)
+
+	input := &ec2.AllocateIpamPoolCidrInput{
+		ClientToken:     aws.String(resource.UniqueId()),
+		IpamPoolId:      aws.String(poolId),
+		PreviewNextCidr: aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("disallowed_cidrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.DisallowedCidrs = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("netmask_length"); ok {
+		input.NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Previewing next IPAM Pool CIDR: %s", input)
+	output, err := conn.AllocateIpamPoolCidr(input)
+
+	if err != nil {
+		return fmt.Errorf("error previewing next CIDR from IPAM pool (%s): %w", poolId, err)
+	}
+
+	if output == nil || output.IpamPoolAllocation == nil {
+		return fmt.Errorf("error previewing next CIDR from IPAM pool (%s): empty response", poolId)
+	}
+
+	cidr := aws.StringValue(output.IpamPoolAllocation.Cidr)
+
+	d.SetId(encodeIpamPreviewNextCidrID(cidr, poolId))
+	d.Set("cidr", cidr)
+
+	return resourceVPCIpamPreviewNextCidrRead(d, meta)
+}
+
+func resourceVPCIpamPreviewNextCidrRead(d *schema.ResourceData, meta interface{}) error {
+	cidr, poolId, err := decodeIpamPreviewNextCidrID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("cidr", cidr)
+	d.Set("ipam_pool_id", poolId)
+
+	return nil
+}
+
+func encodeIpamPreviewNextCidrID(cidr, poolId string) string {
+	return fmt.Sprintf("%s_%s", cidr, poolId)
+}
+
+func decodeIpamPreviewNextCidrID(id string) (string, string, error) {
+	idParts := strings.Split(id, "_")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in the form of cidr_poolId, given: %q", id)
+	}
+	return idParts[0], idParts[1], nil
+}
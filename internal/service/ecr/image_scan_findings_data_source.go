@@ -0,0 +1,286 @@
+package ecr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceImageScanFindings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceImageScanFindingsRead,
+		Schema: map[string]*schema.Schema{
+			"enhanced_findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerability_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"finding_severity_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"image_digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"image_digest", "image_tag"},
+			},
+			"image_scan_completed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_scan_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_scan_status_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"image_digest", "image_tag"},
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vulnerability_source_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceImageScanFindingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	repositoryName := d.Get("repository_name").(string)
+	input := &ecr.DescribeImageScanFindingsInput{
+		ImageId:        &ecr.ImageIdentifier{},
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if v, ok := d.GetOk("image_digest"); ok {
+		input.ImageId.ImageDigest = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_tag"); ok {
+		input.ImageId.ImageTag = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	var output *ecr.DescribeImageScanFindingsOutput
+	var enhancedFindings []*ecr.EnhancedImageScanFinding
+	var findings []*ecr.ImageScanFinding
+
+	log.Printf("[DEBUG] Reading ECR Image Scan Findings: %s", input)
+	err := conn.DescribeImageScanFindingsPages(input, func(page *ecr.DescribeImageScanFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		// Scan metadata is the same on every page.
+		output = page
+
+		if page.ImageScanFindings != nil {
+			enhancedFindings = append(enhancedFindings, page.ImageScanFindings.EnhancedFindings...)
+			findings = append(findings, page.ImageScanFindings.Findings...)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Image Scan Findings (%s): %w", repositoryName, err)
+	}
+
+	if output == nil || output.ImageId == nil {
+		return fmt.Errorf("error reading ECR Image Scan Findings (%s): empty response", repositoryName)
+	}
+
+	d.SetId(fmt.Sprintf("%s@%s", aws.StringValue(output.RepositoryName), aws.StringValue(output.ImageId.ImageDigest)))
+	d.Set("image_digest", output.ImageId.ImageDigest)
+	d.Set("registry_id", output.RegistryId)
+
+	if v := output.ImageScanStatus; v != nil {
+		d.Set("image_scan_status", v.Status)
+		d.Set("image_scan_status_description", v.Description)
+	} else {
+		d.Set("image_scan_status", nil)
+		d.Set("image_scan_status_description", nil)
+	}
+
+	if v := output.ImageScanFindings; v != nil {
+		if v.ImageScanCompletedAt != nil {
+			d.Set("image_scan_completed_at", aws.TimeValue(v.ImageScanCompletedAt).Format(time.RFC3339))
+		} else {
+			d.Set("image_scan_completed_at", nil)
+		}
+
+		if err := d.Set("finding_severity_counts", aws.Int64ValueMap(v.FindingSeverityCounts)); err != nil {
+			return fmt.Errorf("error setting finding_severity_counts: %w", err)
+		}
+
+		if v.VulnerabilitySourceUpdatedAt != nil {
+			d.Set("vulnerability_source_updated_at", aws.TimeValue(v.VulnerabilitySourceUpdatedAt).Format(time.RFC3339))
+		} else {
+			d.Set("vulnerability_source_updated_at", nil)
+		}
+	} else {
+		d.Set("image_scan_completed_at", nil)
+		d.Set("finding_severity_counts", nil)
+		d.Set("vulnerability_source_updated_at", nil)
+	}
+
+	if err := d.Set("enhanced_findings", flattenEcrEnhancedImageScanFindings(enhancedFindings)); err != nil {
+		return fmt.Errorf("error setting enhanced_findings: %w", err)
+	}
+
+	if err := d.Set("findings", flattenEcrImageScanFindings(findings)); err != nil {
+		return fmt.Errorf("error setting findings: %w", err)
+	}
+
+	return nil
+}
+
+func flattenEcrImageScanFindings(apiObjects []*ecr.ImageScanFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		attributes := make(map[string]interface{}, len(apiObject.Attributes))
+
+		for _, v := range apiObject.Attributes {
+			attributes[aws.StringValue(v.Key)] = aws.StringValue(v.Value)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attributes":  attributes,
+			"description": aws.StringValue(apiObject.Description),
+			"name":        aws.StringValue(apiObject.Name),
+			"severity":    aws.StringValue(apiObject.Severity),
+			"uri":         aws.StringValue(apiObject.Uri),
+		})
+	}
+
+	return tfList
+}
+
+func flattenEcrEnhancedImageScanFindings(apiObjects []*ecr.EnhancedImageScanFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"finding_arn": aws.StringValue(apiObject.FindingArn),
+			"score":       aws.Float64Value(apiObject.Score),
+			"severity":    aws.StringValue(apiObject.Severity),
+			"status":      aws.StringValue(apiObject.Status),
+			"title":       aws.StringValue(apiObject.Title),
+			"type":        aws.StringValue(apiObject.Type),
+		}
+
+		if v := apiObject.PackageVulnerabilityDetails; v != nil {
+			tfMap["source_url"] = aws.StringValue(v.SourceUrl)
+			tfMap["vulnerability_id"] = aws.StringValue(v.VulnerabilityId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
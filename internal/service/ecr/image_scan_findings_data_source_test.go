@@ -0,0 +1,59 @@
+package ecr_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECRImageScanFindingsDataSource_basic(t *testing.T) {
+	key := "ECR_IMAGE_SCAN_FINDINGS_REPOSITORY_NAME"
+	repositoryName := os.Getenv(key)
+	if repositoryName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	key = "ECR_IMAGE_SCAN_FINDINGS_IMAGE_TAG"
+	imageTag := os.Getenv(key)
+	if imageTag == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	dataSourceName := "data.aws_ecr_image_scan_findings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageScanFindingsDataSourceConfig(repositoryName, imageTag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "image_digest"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "image_scan_status"),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "registry_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "image_digest", "data.aws_ecr_image_scan_findings.by_digest", "image_digest"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "findings.#", "data.aws_ecr_image_scan_findings.by_digest", "findings.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageScanFindingsDataSourceConfig(repositoryName, imageTag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image_scan_findings" "test" {
+  repository_name = %[1]q
+  image_tag       = %[2]q
+}
+
+data "aws_ecr_image_scan_findings" "by_digest" {
+  repository_name = data.aws_ecr_image_scan_findings.test.repository_name
+  image_digest    = data.aws_ecr_image_scan_findings.test.image_digest
+}
+`, repositoryName, imageTag)
+}
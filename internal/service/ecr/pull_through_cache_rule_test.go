@@ -0,0 +1,149 @@
+package ecr_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecr "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccECRPullThroughCacheRule_basic(t *testing.T) {
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPullThroughCacheRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleConfig(repositoryPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ecr_repository_prefix", repositoryPrefix),
+					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
+					resource.TestCheckResourceAttr(resourceName, "upstream_registry_url", "public.ecr.aws"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccECRPullThroughCacheRule_disappears(t *testing.T) {
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPullThroughCacheRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleConfig(repositoryPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfecr.ResourcePullThroughCacheRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccECRPullThroughCacheRule_failWhenAlreadyExists(t *testing.T) {
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPullThroughCacheRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPullThroughCacheRuleConfigDuplicate(repositoryPrefix),
+				ExpectError: regexp.MustCompile(`PullThroughCacheRuleAlreadyExistsException`),
+			},
+		},
+	})
+}
+
+func testAccCheckPullThroughCacheRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecr_pull_through_cache_rule" {
+			continue
+		}
+
+		_, err := tfecr.FindPullThroughCacheRuleByRepositoryPrefix(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ECR Pull Through Cache Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPullThroughCacheRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Pull Through Cache Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+
+		_, err := tfecr.FindPullThroughCacheRuleByRepositoryPrefix(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPullThroughCacheRuleConfig(repositoryPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[1]q
+  upstream_registry_url = "public.ecr.aws"
+}
+`, repositoryPrefix)
+}
+
+func testAccPullThroughCacheRuleConfigDuplicate(repositoryPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[1]q
+  upstream_registry_url = "public.ecr.aws"
+}
+
+resource "aws_ecr_pull_through_cache_rule" "duplicate" {
+  ecr_repository_prefix = aws_ecr_pull_through_cache_rule.test.ecr_repository_prefix
+  upstream_registry_url = "public.ecr.aws"
+}
+`, repositoryPrefix)
+}
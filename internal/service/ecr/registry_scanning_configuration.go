@@ -0,0 +1,190 @@
+package ecr
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceRegistryScanningConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRegistryScanningConfigurationPut,
+		Read:   resourceRegistryScanningConfigurationRead,
+		Update: resourceRegistryScanningConfigurationPut,
+		Delete: resourceRegistryScanningConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_filter": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"filter_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecr.ScanningRepositoryFilterType_Values(), false),
+									},
+								},
+							},
+						},
+						"scan_frequency": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ecr.ScanFrequency_Values(), false),
+						},
+					},
+				},
+			},
+			"scan_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ecr.ScanType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceRegistryScanningConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	input := &ecr.PutRegistryScanningConfigurationInput{
+		Rules:    expandEcrRegistryScanningRules(d.Get("rule").(*schema.Set).List()),
+		ScanType: aws.String(d.Get("scan_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting ECR Registry Scanning Configuration: %s", input)
+	_, err := conn.PutRegistryScanningConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting ECR Registry Scanning Configuration: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceRegistryScanningConfigurationRead(d, meta)
+}
+
+func resourceRegistryScanningConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	log.Printf("[DEBUG] Reading ECR Registry Scanning Configuration %s", d.Id())
+	output, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.ScanningConfiguration == nil {
+		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): empty response", d.Id())
+	}
+
+	d.Set("registry_id", output.RegistryId)
+	if err := d.Set("rule", flattenEcrRegistryScanningRules(output.ScanningConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+	d.Set("scan_type", output.ScanningConfiguration.ScanType)
+
+	return nil
+}
+
+func resourceRegistryScanningConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	// Revert to the registry's default configuration.
+	log.Printf("[DEBUG] Deleting ECR Registry Scanning Configuration: %s", d.Id())
+	_, err := conn.PutRegistryScanningConfiguration(&ecr.PutRegistryScanningConfigurationInput{
+		Rules:    []*ecr.RegistryScanningRule{},
+		ScanType: aws.String(ecr.ScanTypeBasic),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEcrRegistryScanningRules(tfList []interface{}) []*ecr.RegistryScanningRule {
+	apiObjects := make([]*ecr.RegistryScanningRule, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecr.RegistryScanningRule{
+			ScanFrequency: aws.String(tfMap["scan_frequency"].(string)),
+		}
+
+		if v, ok := tfMap["repository_filter"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.RepositoryFilters = append(apiObject.RepositoryFilters, &ecr.ScanningRepositoryFilter{
+					Filter:     aws.String(tfMap["filter"].(string)),
+					FilterType: aws.String(tfMap["filter_type"].(string)),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEcrRegistryScanningRules(apiObjects []*ecr.RegistryScanningRule) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		repositoryFilters := make([]interface{}, 0, len(apiObject.RepositoryFilters))
+
+		for _, v := range apiObject.RepositoryFilters {
+			repositoryFilters = append(repositoryFilters, map[string]interface{}{
+				"filter":      aws.StringValue(v.Filter),
+				"filter_type": aws.StringValue(v.FilterType),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"repository_filter": repositoryFilters,
+			"scan_frequency":    aws.StringValue(apiObject.ScanFrequency),
+		})
+	}
+
+	return tfList
+}
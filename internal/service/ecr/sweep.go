@@ -17,6 +17,11 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_ecr_pull_through_cache_rule", &resource.Sweeper{
+		Name: "aws_ecr_pull_through_cache_rule",
+		F:    sweepPullThroughCacheRules,
+	})
+
 	resource.AddTestSweepers("aws_ecr_repository", &resource.Sweeper{
 		Name: "aws_ecr_repository",
 		F:    sweepRepositories,
@@ -68,3 +73,47 @@ func sweepRepositories(region string) error {
 
 	return errors
 }
+
+func sweepPullThroughCacheRules(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).ECRConn
+	input := &ecr.DescribePullThroughCacheRulesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribePullThroughCacheRulesPages(input, func(page *ecr.DescribePullThroughCacheRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PullThroughCacheRules {
+			r := ResourcePullThroughCacheRule()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.EcrRepositoryPrefix))
+			d.Set("registry_id", v.RegistryId)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping ECR Pull Through Cache Rule sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing ECR Pull Through Cache Rules (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping ECR Pull Through Cache Rules (%s): %w", region, err)
+	}
+
+	return nil
+}
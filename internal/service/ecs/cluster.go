@@ -51,6 +51,7 @@ func ResourceCluster() *schema.Resource {
 			"capacity_providers": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -114,6 +115,7 @@ func ResourceCluster() *schema.Resource {
 			"default_capacity_provider_strategy": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"base": {
@@ -367,26 +369,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			DefaultCapacityProviderStrategy: expandEcsCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
 		}
 
-		err := resource.Retry(ecsClusterTimeoutUpdate, func() *resource.RetryError {
-			_, err := conn.PutClusterCapacityProviders(&input)
-			if err != nil {
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeClientException, "Cluster was not ACTIVE") {
-					return resource.RetryableError(err)
-				}
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeResourceInUseException, "") {
-					return resource.RetryableError(err)
-				}
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeUpdateInProgressException, "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if tfresource.TimedOut(err) {
-			_, err = conn.PutClusterCapacityProviders(&input)
-		}
-		if err != nil {
+		if err := retryClusterCapacityProvidersPut(conn, &input); err != nil {
 			return fmt.Errorf("error changing ECS cluster capacity provider settings (%s): %w", d.Id(), err)
 		}
 
@@ -446,6 +429,33 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// retryClusterCapacityProvidersPut calls PutClusterCapacityProviders, retrying while the cluster or
+// its capacity providers are busy.
+func retryClusterCapacityProvidersPut(conn *ecs.ECS, input *ecs.PutClusterCapacityProvidersInput) error {
+	err := resource.Retry(ecsClusterTimeoutUpdate, func() *resource.RetryError {
+		_, err := conn.PutClusterCapacityProviders(input)
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, ecs.ErrCodeClientException, "Cluster was not ACTIVE") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, ecs.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, ecs.ErrCodeUpdateInProgressException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.PutClusterCapacityProviders(input)
+	}
+
+	return err
+}
+
 func expandEcsSettings(configured *schema.Set) []*ecs.ClusterSetting {
 	list := configured.List()
 	if len(list) == 0 {
@@ -0,0 +1,223 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+)
+
+func TestAccECSClusterCapacityProviders_basic(t *testing.T) {
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capacity_providers.*", "FARGATE"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_capacity_provider_strategy.*", map[string]string{
+						"base":              "1",
+						"weight":            "100",
+						"capacity_provider": "FARGATE",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccECSClusterCapacityProviders_disappears(t *testing.T) {
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					acctest.CheckResourceDisappears(acctest.Provider, tfecs.ResourceClusterCapacityProviders(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccECSClusterCapacityProviders_update(t *testing.T) {
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "1"),
+				),
+			},
+			{
+				Config: testAccClusterCapacityProvidersConfig_both(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capacity_providers.*", "FARGATE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capacity_providers.*", "FARGATE_SPOT"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "2"),
+				),
+			},
+			{
+				Config: testAccClusterCapacityProvidersConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSClusterCapacityProviders_autoScalingGroup(t *testing.T) {
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersConfig_autoScalingGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "capacity_providers.*", "aws_ecs_capacity_provider.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccClusterCapacityProvidersConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+
+  capacity_providers = ["FARGATE"]
+
+  default_capacity_provider_strategy {
+    base              = 1
+    weight            = 100
+    capacity_provider = "FARGATE"
+  }
+}
+`, rName)
+}
+
+func testAccClusterCapacityProvidersConfig_both(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+
+  capacity_providers = ["FARGATE", "FARGATE_SPOT"]
+
+  default_capacity_provider_strategy {
+    base              = 1
+    weight            = 100
+    capacity_provider = "FARGATE"
+  }
+
+  default_capacity_provider_strategy {
+    weight            = 50
+    capacity_provider = "FARGATE_SPOT"
+  }
+}
+`, rName)
+}
+
+func testAccClusterCapacityProvidersConfig_empty(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+}
+`, rName)
+}
+
+func testAccClusterCapacityProvidersConfig_autoScalingGroup(rName string) string {
+	return testAccCapacityProviderBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn = aws_autoscaling_group.test.arn
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+
+  capacity_providers = [aws_ecs_capacity_provider.test.name]
+
+  default_capacity_provider_strategy {
+    base              = 1
+    weight            = 100
+    capacity_provider = aws_ecs_capacity_provider.test.name
+  }
+}
+`, rName)
+}
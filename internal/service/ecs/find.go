@@ -3,7 +3,9 @@ package ecs
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindCapacityProviderByARN(conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
@@ -65,3 +67,103 @@ func FindClusterByARN(conn *ecs.ECS, arn string) (*ecs.DescribeClustersOutput, e
 
 	return output, nil
 }
+
+func FindClusterByNameOrARN(conn *ecs.ECS, nameOrARN string) (*ecs.Cluster, error) {
+	input := &ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{nameOrARN}),
+	}
+
+	output, err := conn.DescribeClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Clusters) == 0 || output.Clusters[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Clusters); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	cluster := output.Clusters[0]
+
+	if status := aws.StringValue(cluster.Status); status == "INACTIVE" {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return cluster, nil
+}
+
+func FindTaskSetByThreePartKey(conn *ecs.ECS, taskSetID, service, cluster string) (*ecs.TaskSet, error) {
+	input := &ecs.DescribeTaskSetsInput{
+		Cluster:  aws.String(cluster),
+		Include:  aws.StringSlice([]string{ecs.TaskSetFieldTags}),
+		Service:  aws.String(service),
+		TaskSets: aws.StringSlice([]string{taskSetID}),
+	}
+
+	output, err := conn.DescribeTaskSets(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException, ecs.ErrCodeTaskSetNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskSets) == 0 || output.TaskSets[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.TaskSets); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.TaskSets[0], nil
+}
+
+func FindTaskByARN(conn *ecs.ECS, cluster, arn string) (*ecs.Task, error) {
+	input := &ecs.DescribeTasksInput{
+		Cluster: aws.String(cluster),
+		Tasks:   aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.DescribeTasks(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Tasks) == 0 || output.Tasks[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Tasks); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Tasks[0], nil
+}
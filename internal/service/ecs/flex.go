@@ -54,3 +54,36 @@ func flattenECSLoadBalancers(list []*ecs.LoadBalancer) []map[string]interface{}
 	}
 	return result
 }
+
+func expandServiceRegistries(l []interface{}) []*ecs.ServiceRegistry {
+	if len(l) == 0 {
+		return nil
+	}
+
+	srs := make([]*ecs.ServiceRegistry, 0, len(l))
+
+	for _, v := range l {
+		raw, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		sr := &ecs.ServiceRegistry{
+			RegistryArn: aws.String(raw["registry_arn"].(string)),
+		}
+		if port, ok := raw["port"].(int); ok && port != 0 {
+			sr.Port = aws.Int64(int64(port))
+		}
+		if raw, ok := raw["container_port"].(int); ok && raw != 0 {
+			sr.ContainerPort = aws.Int64(int64(raw))
+		}
+		if raw, ok := raw["container_name"].(string); ok && raw != "" {
+			sr.ContainerName = aws.String(raw)
+		}
+
+		srs = append(srs, sr)
+	}
+
+	return srs
+}
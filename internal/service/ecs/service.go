@@ -534,27 +534,8 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.PlacementConstraints = pc
 	}
 
-	serviceRegistries := d.Get("service_registries").([]interface{})
-	if len(serviceRegistries) > 0 {
-		srs := make([]*ecs.ServiceRegistry, 0, len(serviceRegistries))
-		for _, v := range serviceRegistries {
-			raw := v.(map[string]interface{})
-			sr := &ecs.ServiceRegistry{
-				RegistryArn: aws.String(raw["registry_arn"].(string)),
-			}
-			if port, ok := raw["port"].(int); ok && port != 0 {
-				sr.Port = aws.Int64(int64(port))
-			}
-			if raw, ok := raw["container_port"].(int); ok && raw != 0 {
-				sr.ContainerPort = aws.Int64(int64(raw))
-			}
-			if raw, ok := raw["container_name"].(string); ok && raw != "" {
-				sr.ContainerName = aws.String(raw)
-			}
-
-			srs = append(srs, sr)
-		}
-		input.ServiceRegistries = srs
+	if v, ok := d.GetOk("service_registries"); ok && len(v.([]interface{})) > 0 {
+		input.ServiceRegistries = expandServiceRegistries(v.([]interface{}))
 	}
 
 	log.Printf("[DEBUG] Creating ECS service: %s", input)
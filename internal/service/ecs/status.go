@@ -21,6 +21,20 @@ const (
 
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
+
+	taskSetStatusActive   = "ACTIVE"
+	taskSetStatusDraining = "DRAINING"
+	taskSetStatusPrimary  = "PRIMARY"
+
+	// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-lifecycle.html.
+	taskStatusActivating     = "ACTIVATING"
+	taskStatusDeactivating   = "DEACTIVATING"
+	taskStatusDeprovisioning = "DEPROVISIONING"
+	taskStatusPending        = "PENDING"
+	taskStatusProvisioning   = "PROVISIONING"
+	taskStatusRunning        = "RUNNING"
+	taskStatusStopped        = "STOPPED"
+	taskStatusStopping       = "STOPPING"
 )
 
 func statusCapacityProvider(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
@@ -100,3 +114,51 @@ func statusCluster(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.Clusters[0].Status), err
 	}
 }
+
+func statusTaskSet(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskSetByThreePartKey(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusTaskSetStability(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskSetByThreePartKey(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.StabilityStatus), nil
+	}
+}
+
+func statusTask(conn *ecs.ECS, cluster, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskByARN(conn, cluster, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LastStatus), nil
+	}
+}
@@ -77,16 +77,177 @@ func ResourceTaskDefinition() *schema.Resource {
 				Computed: true,
 			},
 
+			"container_definition": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cpu": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"entry_point": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"essential": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"log_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_driver": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+									},
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"memory": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"memory_reservation": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"mount_point": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_path": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"read_only": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"source_volume": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"port_mapping": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(0, 65535),
+									},
+									"host_port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(0, 65535),
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      ecs.TransportProtocolTcp,
+										ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+									},
+								},
+							},
+						},
+						"readonly_root_filesystem": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"secrets": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"working_directory": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v interface{}) string {
-					// Sort the lists of environment variables as they are serialized to state, so we won't get
+					// Sort the lists of environment variables and secrets as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
 					// but they still show in the plan if some other property changes).
 					orderedCDs, _ := expandEcsContainerDefinitions(v.(string))
 					containerDefinitions(orderedCDs).OrderEnvironmentVariables()
+					containerDefinitions(orderedCDs).OrderSecrets()
 					unnormalizedJson, _ := flattenEcsContainerDefinitions(orderedCDs)
 					json, _ := structure.NormalizeJsonString(unnormalizedJson)
 					return json
@@ -419,10 +580,18 @@ func resourceTaskDefinitionCreate(d *schema.ResourceData, meta interface{}) erro
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandEcsContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return err
+	var definitions []*ecs.ContainerDefinition
+
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		definitions = expandEcsContainerDefinitionBlocks(v.([]interface{}))
+	} else {
+		var err error
+
+		definitions, err = expandEcsContainerDefinitions(d.Get("container_definitions").(string))
+
+		if err != nil {
+			return err
+		}
 	}
 
 	input := ecs.RegisterTaskDefinitionInput{
@@ -540,10 +709,11 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("family", taskDefinition.Family)
 	d.Set("revision", taskDefinition.Revision)
 
-	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
+	// Sort the lists of environment variables and secrets as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
 	containerDefinitions(taskDefinition.ContainerDefinitions).OrderEnvironmentVariables()
+	containerDefinitions(taskDefinition.ContainerDefinitions).OrderSecrets()
 
 	defs, err := flattenEcsContainerDefinitions(taskDefinition.ContainerDefinitions)
 	if err != nil {
@@ -554,6 +724,12 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// Both forms are always kept in state so that either can be used after import.
+	// The form that is not configured is computed and is recomputed on replacement.
+	if err := d.Set("container_definition", flattenEcsContainerDefinitionBlocks(taskDefinition.ContainerDefinitions)); err != nil {
+		return fmt.Errorf("error setting container_definition: %w", err)
+	}
+
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set("execution_role_arn", taskDefinition.ExecutionRoleArn)
 	d.Set("cpu", taskDefinition.Cpu)
@@ -1112,3 +1288,226 @@ func flattenEcsTaskDefinitionEphemeralStorage(pc *ecs.EphemeralStorage) []map[st
 
 	return []map[string]interface{}{m}
 }
+
+func expandEcsContainerDefinitionBlocks(tfList []interface{}) []*ecs.ContainerDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]*ecs.ContainerDefinition, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+			for name, value := range v {
+				apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+					Name:  aws.String(name),
+					Value: aws.String(value.(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.LogConfiguration = &ecs.LogConfiguration{
+				LogDriver: aws.String(tfMap["log_driver"].(string)),
+			}
+
+			if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.LogConfiguration.Options = flex.ExpandStringMap(v)
+			}
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.MountPoints = append(apiObject.MountPoints, &ecs.MountPoint{
+					ContainerPath: aws.String(tfMap["container_path"].(string)),
+					ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+					SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				portMapping := &ecs.PortMapping{
+					ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+					Protocol:      aws.String(tfMap["protocol"].(string)),
+				}
+
+				if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+					portMapping.HostPort = aws.Int64(int64(v))
+				}
+
+				apiObject.PortMappings = append(apiObject.PortMappings, portMapping)
+			}
+		}
+
+		if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+			apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["secrets"].(map[string]interface{}); ok && len(v) > 0 {
+			for name, valueFrom := range v {
+				apiObject.Secrets = append(apiObject.Secrets, &ecs.Secret{
+					Name:      aws.String(name),
+					ValueFrom: aws.String(valueFrom.(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	containerDefinitions(apiObjects).OrderEnvironmentVariables()
+	containerDefinitions(apiObjects).OrderSecrets()
+
+	return apiObjects
+}
+
+func flattenEcsContainerDefinitionBlocks(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"command":                  aws.StringValueSlice(apiObject.Command),
+			"cpu":                      aws.Int64Value(apiObject.Cpu),
+			"entry_point":              aws.StringValueSlice(apiObject.EntryPoint),
+			"essential":                apiObject.Essential == nil || aws.BoolValue(apiObject.Essential),
+			"image":                    aws.StringValue(apiObject.Image),
+			"memory":                   aws.Int64Value(apiObject.Memory),
+			"memory_reservation":       aws.Int64Value(apiObject.MemoryReservation),
+			"name":                     aws.StringValue(apiObject.Name),
+			"readonly_root_filesystem": aws.BoolValue(apiObject.ReadonlyRootFilesystem),
+			"user":                     aws.StringValue(apiObject.User),
+			"working_directory":        aws.StringValue(apiObject.WorkingDirectory),
+		}
+
+		if len(apiObject.Environment) > 0 {
+			environment := make(map[string]interface{}, len(apiObject.Environment))
+
+			for _, v := range apiObject.Environment {
+				environment[aws.StringValue(v.Name)] = aws.StringValue(v.Value)
+			}
+
+			tfMap["environment"] = environment
+		}
+
+		if v := apiObject.LogConfiguration; v != nil {
+			tfMap["log_configuration"] = []interface{}{
+				map[string]interface{}{
+					"log_driver": aws.StringValue(v.LogDriver),
+					"options":    aws.StringValueMap(v.Options),
+				},
+			}
+		}
+
+		if len(apiObject.MountPoints) > 0 {
+			mountPoints := make([]interface{}, 0, len(apiObject.MountPoints))
+
+			for _, v := range apiObject.MountPoints {
+				mountPoints = append(mountPoints, map[string]interface{}{
+					"container_path": aws.StringValue(v.ContainerPath),
+					"read_only":      aws.BoolValue(v.ReadOnly),
+					"source_volume":  aws.StringValue(v.SourceVolume),
+				})
+			}
+
+			tfMap["mount_point"] = mountPoints
+		}
+
+		if len(apiObject.PortMappings) > 0 {
+			portMappings := make([]interface{}, 0, len(apiObject.PortMappings))
+
+			for _, v := range apiObject.PortMappings {
+				protocol := aws.StringValue(v.Protocol)
+
+				if protocol == "" {
+					protocol = ecs.TransportProtocolTcp
+				}
+
+				portMappings = append(portMappings, map[string]interface{}{
+					"container_port": aws.Int64Value(v.ContainerPort),
+					"host_port":      aws.Int64Value(v.HostPort),
+					"protocol":       protocol,
+				})
+			}
+
+			tfMap["port_mapping"] = portMappings
+		}
+
+		if len(apiObject.Secrets) > 0 {
+			secrets := make(map[string]interface{}, len(apiObject.Secrets))
+
+			for _, v := range apiObject.Secrets {
+				secrets[aws.StringValue(v.Name)] = aws.StringValue(v.ValueFrom)
+			}
+
+			tfMap["secrets"] = secrets
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
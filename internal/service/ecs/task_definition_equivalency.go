@@ -53,11 +53,19 @@ func ContainerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool,
 	return equal, nil
 }
 
+// Values applied by the ECS API when a container health check omits them.
+const (
+	containerDefinitionHealthCheckDefaultInterval = 30
+	containerDefinitionHealthCheckDefaultRetries  = 3
+	containerDefinitionHealthCheckDefaultTimeout  = 5
+)
+
 type containerDefinitions []*ecs.ContainerDefinition
 
 func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 	// Deal with fields which may be re-ordered in the API
 	cd.OrderEnvironmentVariables()
+	cd.OrderSecrets()
 
 	for i, def := range cd {
 		// Deal with special fields which have defaults
@@ -68,7 +76,7 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 			def.Essential = aws.Bool(true)
 		}
 		for j, pm := range def.PortMappings {
-			if pm.Protocol != nil && *pm.Protocol == "tcp" {
+			if pm.Protocol != nil && *pm.Protocol == ecs.TransportProtocolTcp {
 				cd[i].PortMappings[j].Protocol = nil
 			}
 			if pm.HostPort != nil && *pm.HostPort == 0 {
@@ -78,6 +86,38 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 				cd[i].PortMappings[j].HostPort = cd[i].PortMappings[j].ContainerPort
 			}
 		}
+		for _, mp := range def.MountPoints {
+			if mp.ReadOnly != nil && !*mp.ReadOnly {
+				mp.ReadOnly = nil
+			}
+		}
+		for _, vf := range def.VolumesFrom {
+			if vf.ReadOnly != nil && !*vf.ReadOnly {
+				vf.ReadOnly = nil
+			}
+		}
+		if hc := def.HealthCheck; hc != nil {
+			if hc.Interval == nil {
+				hc.Interval = aws.Int64(containerDefinitionHealthCheckDefaultInterval)
+			}
+			if hc.Retries == nil {
+				hc.Retries = aws.Int64(containerDefinitionHealthCheckDefaultRetries)
+			}
+			if hc.Timeout == nil {
+				hc.Timeout = aws.Int64(containerDefinitionHealthCheckDefaultTimeout)
+			}
+			if hc.StartPeriod != nil && *hc.StartPeriod == 0 {
+				hc.StartPeriod = nil
+			}
+		}
+		if lc := def.LogConfiguration; lc != nil {
+			if len(lc.Options) == 0 {
+				lc.Options = nil
+			}
+			if len(lc.SecretOptions) == 0 {
+				lc.SecretOptions = nil
+			}
+		}
 
 		// Create a mutable copy
 		defCopy, err := copystructure.Copy(def)
@@ -89,8 +129,8 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 		for i := 0; i < definition.NumField(); i++ {
 			sf := definition.Field(i)
 
-			// Set all empty slices to nil
-			if sf.Kind() == reflect.Slice {
+			// Set all empty slices and maps to nil
+			if sf.Kind() == reflect.Slice || sf.Kind() == reflect.Map {
 				if sf.IsValid() && !sf.IsNil() && sf.Len() == 0 {
 					sf.Set(reflect.Zero(sf.Type()))
 				}
@@ -104,8 +144,16 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 
 func (cd containerDefinitions) OrderEnvironmentVariables() {
 	for _, def := range cd {
-		sort.Slice(def.Environment, func(i, j int) bool {
+		sort.SliceStable(def.Environment, func(i, j int) bool {
 			return aws.StringValue(def.Environment[i].Name) < aws.StringValue(def.Environment[j].Name)
 		})
 	}
 }
+
+func (cd containerDefinitions) OrderSecrets() {
+	for _, def := range cd {
+		sort.SliceStable(def.Secrets, func(i, j int) bool {
+			return aws.StringValue(def.Secrets[i].Name) < aws.StringValue(def.Secrets[j].Name)
+		})
+	}
+}
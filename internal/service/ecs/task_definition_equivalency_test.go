@@ -563,3 +563,178 @@ func TestContainerDefinitionsAreEquivalent_missingEnvironmentName(t *testing.T)
 		t.Fatal("Expected definitions to be equal.")
 	}
 }
+
+func TestContainerDefinitionsAreEquivalent_secrets(t *testing.T) {
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "essential": true,
+      "memory": 500,
+      "secrets": [
+        {"name": "DB_PASSWORD", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/db_password"},
+        {"name": "API_KEY", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/api_key"}
+      ]
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "memory": 500,
+        "essential": true,
+        "secrets": [
+          {"name": "API_KEY", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/api_key"},
+          {"name": "DB_PASSWORD", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/db_password"}
+        ],
+        "environment": [],
+        "mountPoints": [],
+        "volumesFrom": []
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_healthCheck(t *testing.T) {
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "essential": true,
+      "memory": 500,
+      "healthCheck": {
+        "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+      }
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "memory": 500,
+        "essential": true,
+        "healthCheck": {
+          "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+          "interval": 30,
+          "retries": 3,
+          "timeout": 5,
+          "startPeriod": 0
+        },
+        "environment": [],
+        "mountPoints": [],
+        "volumesFrom": []
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_healthCheckNegative(t *testing.T) {
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "essential": true,
+      "memory": 500,
+      "healthCheck": {
+        "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+        "interval": 60
+      }
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "memory": 500,
+        "essential": true,
+        "healthCheck": {
+          "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+          "interval": 30,
+          "retries": 3,
+          "timeout": 5
+        }
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if equal {
+		t.Fatal("Expected definitions to differ.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_defaults(t *testing.T) {
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "memory": 500,
+      "mountPoints": [
+        {"sourceVolume": "data", "containerPath": "/var/www"}
+      ],
+      "volumesFrom": [
+        {"sourceContainer": "sidecar"}
+      ],
+      "logConfiguration": {
+        "logDriver": "awslogs"
+      }
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "memory": 500,
+        "essential": true,
+        "dockerLabels": {},
+        "environment": [],
+        "mountPoints": [
+          {"sourceVolume": "data", "containerPath": "/var/www", "readOnly": false}
+        ],
+        "volumesFrom": [
+          {"sourceContainer": "sidecar", "readOnly": false}
+        ],
+        "logConfiguration": {
+          "logDriver": "awslogs",
+          "options": {},
+          "secretOptions": []
+        }
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
@@ -883,6 +883,61 @@ func TestAccECSTaskDefinition_inferenceAccelerator(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_containerDefinitionBlock(t *testing.T) {
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionContainerDefinitionBlockConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.image", "nginx:latest"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.mount_point.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				// Configuration re-applied with no changes.
+				Config:   testAccTaskDefinitionContainerDefinitionBlockConfig(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				// Switching to an equivalent JSON form is not a change.
+				Config:   testAccTaskDefinitionContainerDefinitionBlockJSONConfig(rName, "VARVAL1"),
+				PlanOnly: true,
+			},
+			{
+				// Switching to a different JSON form replaces the task definition and recomputes the block form.
+				Config: testAccTaskDefinitionContainerDefinitionBlockJSONConfig(rName, "VARVAL1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.VARNAME1", "VARVAL1updated"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTaskDefinitionProxyConfigurationConfig(rName string, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) string {
@@ -2467,3 +2522,73 @@ resource "aws_fsx_windows_file_system" "test" {
 }
 `
 }
+
+func testAccTaskDefinitionContainerDefinitionBlockConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 10
+    memory = 128
+
+    environment = {
+      VARNAME2 = "VARVAL2"
+      VARNAME1 = "VARVAL1"
+    }
+
+    port_mapping {
+      container_port = 80
+      host_port      = 8080
+    }
+
+    mount_point {
+      source_volume  = "data"
+      container_path = "/usr/share/nginx/html"
+    }
+  }
+
+  volume {
+    name      = "data"
+    host_path = "/ecs/data"
+  }
+}
+`, rName)
+}
+
+func testAccTaskDefinitionContainerDefinitionBlockJSONConfig(rName, varValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = jsonencode([
+    {
+      name   = "web"
+      image  = "nginx:latest"
+      cpu    = 10
+      memory = 128
+
+      environment = [
+        { name = "VARNAME1", value = %[2]q },
+        { name = "VARNAME2", value = "VARVAL2" },
+      ]
+
+      portMappings = [
+        { containerPort = 80, hostPort = 8080 },
+      ]
+
+      mountPoints = [
+        { sourceVolume = "data", containerPath = "/usr/share/nginx/html" },
+      ]
+    },
+  ])
+
+  volume {
+    name      = "data"
+    host_path = "/ecs/data"
+  }
+}
+`, rName, varValue1)
+}
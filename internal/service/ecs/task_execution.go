@@ -0,0 +1,492 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTaskExecution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTaskExecutionCreate,
+		// Stopped tasks are only retained by ECS for a short time, so the
+		// outcome of the run is kept in state as recorded at creation.
+		Read:   schema.Noop,
+		Delete: schema.Noop,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_provider_strategy": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_type"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"exit_codes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"launch_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"capacity_provider_strategy"},
+				ValidateFunc:  validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"cpu": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"environment": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"memory": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"memory_reservation": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"cpu": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"execution_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"memory": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"task_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"propagate_tags": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
+			},
+			"started_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+			"stop_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stopped_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchemaForceNew(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"task_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// The task can not be updated, so changes to the provider default tags run it again.
+			customdiff.ForceNewIfChange("tags_all", func(_ context.Context, old, new, meta interface{}) bool {
+				return !tftags.New(old).Equal(tftags.New(new))
+			}),
+		),
+	}
+}
+
+func resourceTaskExecutionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	cluster := d.Get("cluster").(string)
+	taskDefinition := d.Get("task_definition").(string)
+	input := &ecs.RunTaskInput{
+		Cluster:        aws.String(cluster),
+		Count:          aws.Int64(1),
+		TaskDefinition: aws.String(taskDefinition),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("capacity_provider_strategy"); ok && v.(*schema.Set).Len() > 0 {
+		input.CapacityProviderStrategy = expandEcsCapacityProviderStrategy(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("group"); ok {
+		input.Group = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_configuration"); ok {
+		input.NetworkConfiguration = expandEcsNetworkConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("overrides"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Overrides = expandTaskOverride(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("platform_version"); ok {
+		input.PlatformVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("propagate_tags"); ok {
+		input.PropagateTags = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("started_by"); ok {
+		input.StartedBy = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Running ECS Task: %s", input)
+	output, err := conn.RunTask(input)
+
+	if err != nil {
+		return fmt.Errorf("error running ECS Task (%s) in cluster (%s): %w", taskDefinition, cluster, err)
+	}
+
+	if output == nil || len(output.Tasks) == 0 || output.Tasks[0] == nil {
+		if output != nil && len(output.Failures) > 0 && output.Failures[0] != nil {
+			failure := output.Failures[0]
+			return fmt.Errorf("error running ECS Task (%s) in cluster (%s): %s: %s", taskDefinition, cluster, aws.StringValue(failure.Reason), aws.StringValue(failure.Detail))
+		}
+
+		return fmt.Errorf("error running ECS Task (%s) in cluster (%s): empty result", taskDefinition, cluster)
+	}
+
+	taskARN := aws.StringValue(output.Tasks[0].TaskArn)
+
+	task, err := waitTaskStopped(conn, cluster, taskARN, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for ECS Task (%s) to stop: %w", taskARN, err)
+	}
+
+	essential, err := findTaskDefinitionEssentialContainers(conn, aws.StringValue(task.TaskDefinitionArn))
+
+	if err != nil {
+		return fmt.Errorf("error reading ECS Task Definition (%s): %w", aws.StringValue(task.TaskDefinitionArn), err)
+	}
+
+	exitCodes := make(map[string]interface{})
+	var failures []string
+
+	for _, container := range task.Containers {
+		if container == nil {
+			continue
+		}
+
+		name := aws.StringValue(container.Name)
+
+		if container.ExitCode != nil {
+			exitCodes[name] = int(aws.Int64Value(container.ExitCode))
+		}
+
+		if !essential[name] {
+			continue
+		}
+
+		if container.ExitCode == nil {
+			failures = append(failures, fmt.Sprintf("container (%s) did not exit: %s", name, aws.StringValue(container.Reason)))
+		} else if exitCode := aws.Int64Value(container.ExitCode); exitCode != 0 {
+			failures = append(failures, fmt.Sprintf("container (%s) exited with code %d", name, exitCode))
+		}
+	}
+
+	if len(failures) > 0 {
+		sort.Strings(failures)
+
+		return fmt.Errorf("ECS Task (%s) failed (%s): %s", taskARN, aws.StringValue(task.StoppedReason), strings.Join(failures, ", "))
+	}
+
+	d.SetId(taskARN)
+	d.Set("exit_codes", exitCodes)
+	d.Set("stop_code", task.StopCode)
+	d.Set("stopped_reason", task.StoppedReason)
+	d.Set("task_arn", taskARN)
+
+	// Tags propagated from the task definition are not read back, as that
+	// would cause the task to be run again on the next apply.
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+// findTaskDefinitionEssentialContainers returns whether each container in the
+// task definition is essential. Containers are essential unless stated otherwise.
+func findTaskDefinitionEssentialContainers(conn *ecs.ECS, taskDefinition string) (map[string]bool, error) {
+	output, err := conn.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinition),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TaskDefinition == nil {
+		return nil, fmt.Errorf("empty result")
+	}
+
+	essential := make(map[string]bool)
+
+	for _, containerDefinition := range output.TaskDefinition.ContainerDefinitions {
+		if containerDefinition == nil {
+			continue
+		}
+
+		essential[aws.StringValue(containerDefinition.Name)] = containerDefinition.Essential == nil || aws.BoolValue(containerDefinition.Essential)
+	}
+
+	return essential, nil
+}
+
+func expandTaskOverride(tfMap map[string]interface{}) *ecs.TaskOverride {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.TaskOverride{}
+
+	if v, ok := tfMap["container_override"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ContainerOverrides = expandContainerOverrides(v)
+	}
+
+	if v, ok := tfMap["cpu"].(string); ok && v != "" {
+		apiObject.Cpu = aws.String(v)
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["memory"].(string); ok && v != "" {
+		apiObject.Memory = aws.String(v)
+	}
+
+	if v, ok := tfMap["task_role_arn"].(string); ok && v != "" {
+		apiObject.TaskRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerOverrides(tfList []interface{}) []*ecs.ContainerOverride {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.ContainerOverride
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerOverride{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+			for _, raw := range v.List() {
+				env := raw.(map[string]interface{})
+
+				apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+					Name:  aws.String(env["key"].(string)),
+					Value: aws.String(env["value"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,299 @@
+package ecs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSTaskExecution_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionConfig(rName, "exit 0", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARN(resourceName, "task_arn", "ecs", regexp.MustCompile(fmt.Sprintf("task/%s/.+", rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "task_arn"),
+					resource.TestCheckResourceAttr(resourceName, "exit_codes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exit_codes.migrate", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stop_code", ecs.TaskStopCodeEssentialContainerExited),
+					resource.TestCheckResourceAttrSet(resourceName, "stopped_reason"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskExecution_nonZeroExitCode(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskExecutionConfig(rName, "exit 3", "first"),
+				ExpectError: regexp.MustCompile(`container \(migrate\) exited with code 3`),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskExecution_triggers(t *testing.T) {
+	var taskARN string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionConfig(rName, "exit 0", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExecutionTaskARN(resourceName, &taskARN, false),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "first"),
+				),
+			},
+			{
+				Config: testAccTaskExecutionConfig(rName, "exit 0", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExecutionTaskARN(resourceName, &taskARN, true),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskExecution_defaultTags(t *testing.T) {
+	var providers []*schema.Provider
+	var taskARN string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccTaskExecutionConfigTags1(rName, "key1", "value1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExecutionTaskARN(resourceName, &taskARN, false),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccTaskExecutionConfigTags1(rName, "key1", "value1"),
+				),
+				PlanOnly: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1updated"),
+					testAccTaskExecutionConfigTags1(rName, "key1", "value1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExecutionTaskARN(resourceName, &taskARN, true),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1updated"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckTaskExecutionTaskARN records the task ARN and, when compare is set,
+// verifies that the task was run again.
+func testAccCheckTaskExecutionTaskARN(n string, taskARN *string, compare bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		v := rs.Primary.Attributes["task_arn"]
+
+		if compare && v == *taskARN {
+			return fmt.Errorf("ECS Task (%s) was not run again", v)
+		}
+
+		*taskARN = v
+
+		return nil
+	}
+}
+
+func testAccTaskExecutionBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone       = data.aws_availability_zones.available.names[0]
+  cidr_block              = "10.0.0.0/24"
+  map_public_ip_on_launch = true
+  vpc_id                  = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  route_table_id = aws_route_table.test.id
+  subnet_id      = aws_subnet.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  cpu                      = "256"
+  memory                   = "512"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "essential": true,
+    "image": "public.ecr.aws/docker/library/busybox:latest",
+    "name": "migrate",
+    "command": ["sh", "-c", "true"]
+  }
+]
+DEFINITION
+}
+`, rName))
+}
+
+func testAccTaskExecutionConfig(rName, command, trigger string) string {
+	return acctest.ConfigCompose(testAccTaskExecutionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ecs_task_execution" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    assign_public_ip = true
+    security_groups  = [aws_security_group.test.id]
+    subnets          = [aws_subnet.test.id]
+  }
+
+  overrides {
+    container_override {
+      name    = "migrate"
+      command = ["sh", "-c", %[1]q]
+
+      environment {
+        key   = "TRIGGER"
+        value = %[2]q
+      }
+    }
+  }
+
+  triggers = {
+    version = %[2]q
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`, command, trigger))
+}
+
+func testAccTaskExecutionConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTaskExecutionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ecs_task_execution" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    assign_public_ip = true
+    security_groups  = [aws_security_group.test.id]
+    subnets          = [aws_subnet.test.id]
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`, tagKey1, tagValue1))
+}
@@ -21,6 +21,8 @@ const (
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
 	clusterAvailableDelay   = 10 * time.Second
+
+	taskStoppedMinTimeout = 10 * time.Second
 )
 
 func waitCapacityProviderDeleted(conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
@@ -153,3 +155,63 @@ func waitClusterDeleted(conn *ecs.ECS, arn string) (*ecs.Cluster, error) {
 
 	return nil, err
 }
+
+func waitTaskSetStable(conn *ecs.ECS, taskSetID, service, cluster string, timeout time.Duration) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecs.StabilityStatusStabilizing},
+		Target:  []string{ecs.StabilityStatusSteadyState},
+		Refresh: statusTaskSetStability(conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitTaskSetDeleted(conn *ecs.ECS, taskSetID, service, cluster string, timeout time.Duration) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{taskSetStatusActive, taskSetStatusDraining, taskSetStatusPrimary},
+		Target:  []string{},
+		Refresh: statusTaskSet(conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitTaskStopped(conn *ecs.ECS, cluster, arn string, timeout time.Duration) (*ecs.Task, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			taskStatusProvisioning,
+			taskStatusPending,
+			taskStatusActivating,
+			taskStatusRunning,
+			taskStatusDeactivating,
+			taskStatusStopping,
+			taskStatusDeprovisioning,
+		},
+		Target:     []string{taskStatusStopped},
+		Refresh:    statusTask(conn, cluster, arn),
+		Timeout:    timeout,
+		MinTimeout: taskStoppedMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.Task); ok {
+		return v, err
+	}
+
+	return nil, err
+}
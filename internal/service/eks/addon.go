@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,7 +34,10 @@ func ResourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffAddonVersionCompatibility,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"addon_name": {
@@ -69,9 +74,23 @@ func ResourceAddon() *schema.Resource {
 				Computed: true,
 			},
 			"resolve_conflicts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+				Deprecated:    `Use "resolve_conflicts_on_create" and/or "resolve_conflicts_on_update" instead`,
+				ConflictsWith: []string{"resolve_conflicts_on_create", "resolve_conflicts_on_update"},
+			},
+			"resolve_conflicts_on_create": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(resolveConflictsOnCreate_Values(), false),
+				ConflictsWith: []string{"resolve_conflicts"},
+			},
+			"resolve_conflicts_on_update": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+				ConflictsWith: []string{"resolve_conflicts"},
 			},
 			"service_account_role_arn": {
 				Type:         schema.TypeString,
@@ -103,7 +122,9 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.AddonVersion = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("resolve_conflicts"); ok {
+	if v, ok := d.GetOk("resolve_conflicts_on_create"); ok {
+		input.ResolveConflicts = aws.String(v.(string))
+	} else if v, ok := d.GetOk("resolve_conflicts"); ok {
 		input.ResolveConflicts = aws.String(v.(string))
 	}
 
@@ -227,8 +248,10 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.AddonVersion = aws.String(d.Get("addon_version").(string))
 		}
 
-		if v, ok := d.GetOk("resolve_conflicts"); ok {
-			input.ResolveConflicts = aws.String(v.(string))
+		resolveConflicts := addonResolveConflictsOnUpdate(d)
+
+		if resolveConflicts != "" {
+			input.ResolveConflicts = aws.String(resolveConflicts)
 		}
 
 		// If service account role ARN is already provided, use it. Otherwise, the add-on uses
@@ -248,12 +271,12 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		_, err = waitAddonUpdateSuccessful(ctx, conn, clusterName, addonName, updateID)
 
 		if err != nil {
-			if d.Get("resolve_conflicts") != eks.ResolveConflictsOverwrite {
+			if resolveConflicts != eks.ResolveConflictsOverwrite {
 				// Changing addon version w/o setting resolve_conflicts to "OVERWRITE"
 				// might result in a failed update if there are conflicts:
 				// ConfigurationConflict	Apply failed with 1 conflict: conflict with "kubectl"...
 				return diag.FromErr(fmt.Errorf("error waiting for EKS Add-On (%s) update (%s): %w, consider setting attribute %q to %q",
-					d.Id(), updateID, err, "resolve_conflicts", eks.ResolveConflictsOverwrite))
+					d.Id(), updateID, err, "resolve_conflicts_on_update", eks.ResolveConflictsOverwrite))
 			}
 
 			return diag.FromErr(fmt.Errorf("error waiting for EKS Add-On (%s) update (%s): %w", d.Id(), updateID, err))
@@ -297,3 +320,79 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+// resolveConflictsOnCreate_Values returns the conflict resolution methods accepted by CreateAddon.
+// PRESERVE is only supported when updating an add-on.
+func resolveConflictsOnCreate_Values() []string {
+	return []string{
+		eks.ResolveConflictsNone,
+		eks.ResolveConflictsOverwrite,
+	}
+}
+
+func addonResolveConflictsOnUpdate(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("resolve_conflicts_on_update"); ok {
+		return v.(string)
+	}
+
+	return d.Get("resolve_conflicts").(string)
+}
+
+// customizeDiffAddonVersionCompatibility checks at plan time that a configured add-on version
+// is compatible with the Kubernetes version of an existing cluster.
+func customizeDiffAddonVersionCompatibility(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("addon_name") || !diff.NewValueKnown("addon_version") || !diff.NewValueKnown("cluster_name") {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("addon_version") {
+		return nil
+	}
+
+	addonVersion := diff.Get("addon_version").(string)
+
+	if addonVersion == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSConn
+	addonName := diff.Get("addon_name").(string)
+	clusterName := diff.Get("cluster_name").(string)
+
+	cluster, err := FindClusterByName(conn, clusterName)
+
+	// The cluster is created in the same apply.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EKS Cluster (%s): %w", clusterName, err)
+	}
+
+	kubernetesVersion := aws.StringValue(cluster.Version)
+	versions, err := FindAddonVersionsByAddonNameAndKubernetesVersion(ctx, conn, addonName, kubernetesVersion)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("EKS Add-On (%s) is not available for Kubernetes version %s", addonName, kubernetesVersion)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EKS Add-On (%s) versions: %w", addonName, err)
+	}
+
+	compatibleVersions := make([]string, 0, len(versions))
+
+	for _, v := range versions {
+		version := aws.StringValue(v.AddonVersion)
+
+		if version == addonVersion {
+			return nil
+		}
+
+		compatibleVersions = append(compatibleVersions, version)
+	}
+
+	return fmt.Errorf("EKS Add-On (%s) version %s is not compatible with EKS Cluster (%s) Kubernetes version %s, compatible versions: %s",
+		addonName, addonVersion, clusterName, kubernetesVersion, strings.Join(compatibleVersions, ", "))
+}
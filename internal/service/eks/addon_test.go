@@ -179,6 +179,72 @@ func TestAccEKSAddon_resolveConflicts(t *testing.T) {
 	})
 }
 
+func TestAccEKSAddon_resolveConflictsOnCreateAndUpdate(t *testing.T) {
+	var addon1, addon2 eks.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+	ctx := context.TODO()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckAddon(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonResolveConflictsOnCreateAndUpdateConfig(rName, addonName, eks.ResolveConflictsOverwrite, eks.ResolveConflictsPreserve),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon1),
+					resource.TestCheckResourceAttr(resourceName, "resolve_conflicts_on_create", eks.ResolveConflictsOverwrite),
+					resource.TestCheckResourceAttr(resourceName, "resolve_conflicts_on_update", eks.ResolveConflictsPreserve),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resolve_conflicts_on_create", "resolve_conflicts_on_update"},
+			},
+			{
+				Config: testAccAddonResolveConflictsOnCreateAndUpdateConfig(rName, addonName, eks.ResolveConflictsOverwrite, eks.ResolveConflictsNone),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon2),
+					resource.TestCheckResourceAttr(resourceName, "resolve_conflicts_on_create", eks.ResolveConflictsOverwrite),
+					resource.TestCheckResourceAttr(resourceName, "resolve_conflicts_on_update", eks.ResolveConflictsNone),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSAddon_addonVersionIncompatible(t *testing.T) {
+	var addon eks.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+	ctx := context.TODO()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckAddon(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddon_Basic(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+				),
+			},
+			{
+				Config:      testAccAddonAddonVersionConfig(rName, addonName, "v0.0.1-eksbuild.1"),
+				ExpectError: regexp.MustCompile(`is not compatible with EKS Cluster`),
+			},
+		},
+	})
+}
+
 func TestAccEKSAddon_serviceAccountRoleARN(t *testing.T) {
 	var addon eks.Addon
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -835,6 +901,17 @@ resource "aws_eks_addon" "test" {
 `, rName, addonName, resolveConflicts))
 }
 
+func testAccAddonResolveConflictsOnCreateAndUpdateConfig(rName, addonName, resolveConflictsOnCreate, resolveConflictsOnUpdate string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_Base(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name                = aws_eks_cluster.test.name
+  addon_name                  = %[2]q
+  resolve_conflicts_on_create = %[3]q
+  resolve_conflicts_on_update = %[4]q
+}
+`, rName, addonName, resolveConflictsOnCreate, resolveConflictsOnUpdate))
+}
+
 func testAccAddonServiceAccountRoleARNConfig(rName, addonName string) string {
 	return acctest.ConfigCompose(testAccAddonConfig_Base(rName), fmt.Sprintf(`
 resource "aws_iam_role" "test-service-role" {
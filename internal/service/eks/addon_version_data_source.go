@@ -0,0 +1,84 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceAddonVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAddonVersionRead,
+		Schema: map[string]*schema.Schema{
+			"addon_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"kubernetes_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+
+	addonName := d.Get("addon_name").(string)
+	kubernetesVersion := d.Get("kubernetes_version").(string)
+
+	versions, err := FindAddonVersionsByAddonNameAndKubernetesVersion(ctx, conn, addonName, kubernetesVersion)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading EKS Add-On (%s) versions for Kubernetes version %s: %w", addonName, kubernetesVersion, err))
+	}
+
+	var version *eks.AddonVersionInfo
+
+	if d.Get("most_recent").(bool) {
+		version = versions[0]
+	} else {
+		version = addonDefaultVersion(versions, kubernetesVersion)
+	}
+
+	if version == nil {
+		return diag.FromErr(fmt.Errorf("no default version of EKS Add-On (%s) found for Kubernetes version %s", addonName, kubernetesVersion))
+	}
+
+	d.SetId(addonName)
+	d.Set("addon_name", addonName)
+	d.Set("kubernetes_version", kubernetesVersion)
+	d.Set("most_recent", d.Get("most_recent").(bool))
+	d.Set("version", version.AddonVersion)
+
+	return nil
+}
+
+func addonDefaultVersion(versions []*eks.AddonVersionInfo, kubernetesVersion string) *eks.AddonVersionInfo {
+	for _, v := range versions {
+		for _, compatibility := range v.Compatibilities {
+			if aws.StringValue(compatibility.ClusterVersion) == kubernetesVersion && aws.BoolValue(compatibility.DefaultVersion) {
+				return v
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
+	var addon eks.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	versionDataSourceName := "data.aws_eks_addon_version.test"
+	versionMostRecentDataSourceName := "data.aws_eks_addon_version.most_recent"
+	addonResourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+	ctx := context.TODO()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckAddon(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonVersionDataSourceConfig(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, addonResourceName, &addon),
+					resource.TestCheckResourceAttrPair(versionDataSourceName, "version", addonResourceName, "addon_version"),
+					resource.TestCheckResourceAttr(versionDataSourceName, "addon_name", addonName),
+					resource.TestCheckResourceAttr(versionDataSourceName, "most_recent", "false"),
+					resource.TestMatchResourceAttr(versionMostRecentDataSourceName, "version", regexp.MustCompile(`^v\d+\.\d+\.\d+`)),
+					resource.TestCheckResourceAttr(versionMostRecentDataSourceName, "most_recent", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddonVersionDataSourceConfig(rName, addonName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_Base(rName), fmt.Sprintf(`
+data "aws_eks_addon_version" "test" {
+  addon_name         = %[2]q
+  kubernetes_version = aws_eks_cluster.test.version
+}
+
+data "aws_eks_addon_version" "most_recent" {
+  addon_name         = %[2]q
+  kubernetes_version = aws_eks_cluster.test.version
+  most_recent        = true
+}
+
+resource "aws_eks_addon" "test" {
+  addon_name        = %[2]q
+  cluster_name      = aws_eks_cluster.test.name
+  addon_version     = data.aws_eks_addon_version.test.version
+  resolve_conflicts = "OVERWRITE"
+}
+`, rName, addonName))
+}
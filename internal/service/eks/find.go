@@ -2,10 +2,12 @@ package eks
 
 import (
 	"context"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -38,6 +40,81 @@ func FindAddonByClusterNameAndAddonName(ctx context.Context, conn *eks.EKS, clus
 	return output.Addon, nil
 }
 
+// FindAddonVersionsByAddonNameAndKubernetesVersion returns the versions of an add-on that are compatible
+// with a Kubernetes version, sorted most recent first.
+func FindAddonVersionsByAddonNameAndKubernetesVersion(ctx context.Context, conn *eks.EKS, addonName, kubernetesVersion string) ([]*eks.AddonVersionInfo, error) {
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String(addonName),
+		KubernetesVersion: aws.String(kubernetesVersion),
+	}
+	var versions []*eks.AddonVersionInfo
+
+	err := conn.DescribeAddonVersionsPagesWithContext(ctx, input, func(page *eks.DescribeAddonVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, addon := range page.Addons {
+			if addon == nil || aws.StringValue(addon.AddonName) != addonName {
+				continue
+			}
+
+			for _, v := range addon.AddonVersions {
+				if v != nil {
+					versions = append(versions, v)
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	// DescribeAddonVersions does not guarantee any order.
+	sortAddonVersionsDescending(versions)
+
+	return versions, nil
+}
+
+// sortAddonVersionsDescending sorts add-on versions of the form vX.Y.Z-eksbuild.N, most recent first.
+// Versions that can not be parsed are sorted last.
+func sortAddonVersionsDescending(versions []*eks.AddonVersionInfo) {
+	parsed := make(map[*eks.AddonVersionInfo]*gversion.Version, len(versions))
+
+	for _, v := range versions {
+		if version, err := gversion.NewVersion(aws.StringValue(v.AddonVersion)); err == nil {
+			parsed[v] = version
+		}
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		vi, vj := parsed[versions[i]], parsed[versions[j]]
+
+		if vi == nil || vj == nil {
+			return vi != nil
+		}
+
+		return vi.GreaterThan(vj)
+	})
+}
+
 func FindAddonUpdateByClusterNameAddonNameAndID(ctx context.Context, conn *eks.EKS, clusterName, addonName, id string) (*eks.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -0,0 +1,292 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRegisteredCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRegisteredClusterCreate,
+		ReadWithoutTimeout:   resourceRegisteredClusterRead,
+		UpdateWithoutTimeout: resourceRegisteredClusterUpdate,
+		DeleteWithoutTimeout: resourceRegisteredClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(registeredClusterCreatedTimeout),
+			Delete: schema.DefaultTimeout(registeredClusterDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activation_code": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"activation_expiry": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"activation_id": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connector_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(eks.ConnectorConfigProvider_Values(), false),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceRegisteredClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &eks.RegisterClusterInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		ConnectorConfig:    expandConnectorConfigRequest(d.Get("connector_config").([]interface{})),
+		Name:               aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Registering EKS Cluster: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.RegisterClusterWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
+				return true, err
+			}
+
+			// InvalidParameterException: Role could not be assumed because the trusted entity is not correct
+			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "Role could not be assumed") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error registering EKS Cluster (%s): %w", name, err))
+	}
+
+	d.SetId(name)
+
+	// The activation code is only guaranteed to be returned by RegisterCluster.
+	if output := outputRaw.(*eks.RegisterClusterOutput); output.Cluster != nil {
+		setConnectorConfigActivation(d, output.Cluster.ConnectorConfig)
+	}
+
+	_, err = waitRegisteredClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) registration: %w", d.Id(), err))
+	}
+
+	return resourceRegisteredClusterRead(ctx, d, meta)
+}
+
+func resourceRegisteredClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	cluster, err := FindClusterByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Registered Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading EKS Registered Cluster (%s): %w", d.Id(), err))
+	}
+
+	if cluster.ConnectorConfig == nil {
+		return diag.Errorf("EKS Cluster (%s) is not a registered cluster", d.Id())
+	}
+
+	d.Set("arn", cluster.Arn)
+	if err := d.Set("connector_config", flattenConnectorConfigResponse(cluster.ConnectorConfig)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting connector_config: %w", err))
+	}
+	if cluster.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(cluster.CreatedAt).Format(time.RFC3339))
+	}
+	d.Set("name", cluster.Name)
+	d.Set("status", cluster.Status)
+	setConnectorConfigActivation(d, cluster.ConnectorConfig)
+
+	tags := KeyValueTags(cluster.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceRegisteredClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
+		}
+	}
+
+	return resourceRegisteredClusterRead(ctx, d, meta)
+}
+
+func resourceRegisteredClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+
+	log.Printf("[DEBUG] Deregistering EKS Cluster: %s", d.Id())
+	_, err := conn.DeregisterClusterWithContext(ctx, &eks.DeregisterClusterInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deregistering EKS Cluster (%s): %w", d.Id(), err))
+	}
+
+	_, err = waitRegisteredClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) deregistration: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// setConnectorConfigActivation records the EKS Connector activation details.
+// DescribeCluster does not always return the activation code, so existing values are preserved.
+func setConnectorConfigActivation(d *schema.ResourceData, apiObject *eks.ConnectorConfigResponse) {
+	if apiObject == nil {
+		return
+	}
+
+	if v := aws.StringValue(apiObject.ActivationCode); v != "" {
+		d.Set("activation_code", v)
+	}
+
+	if v := aws.StringValue(apiObject.ActivationId); v != "" {
+		d.Set("activation_id", v)
+	}
+
+	if apiObject.ActivationExpiry != nil {
+		d.Set("activation_expiry", aws.TimeValue(apiObject.ActivationExpiry).Format(time.RFC3339))
+	}
+}
+
+func expandConnectorConfigRequest(tfList []interface{}) *eks.ConnectorConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &eks.ConnectorConfigRequest{}
+
+	if v, ok := tfMap["provider"].(string); ok && v != "" {
+		apiObject.Provider = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenConnectorConfigResponse(apiObject *eks.ConnectorConfigResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"provider": aws.StringValue(apiObject.Provider),
+		"role_arn": aws.StringValue(apiObject.RoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,450 @@
+package eks_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEKSRegisteredCluster_basic(t *testing.T) {
+	var cluster eks.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_registered_cluster.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRegisteredClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredClusterExists(resourceName, &cluster),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "eks", fmt.Sprintf("cluster/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "activation_code"),
+					resource.TestCheckResourceAttrSet(resourceName, "activation_expiry"),
+					resource.TestCheckResourceAttrSet(resourceName, "activation_id"),
+					resource.TestCheckResourceAttr(resourceName, "connector_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_config.0.provider", eks.ConnectorConfigProviderOther),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_config.0.role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", eks.ClusterStatusPending),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_code"},
+			},
+		},
+	})
+}
+
+func TestAccEKSRegisteredCluster_disappears(t *testing.T) {
+	var cluster eks.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_registered_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRegisteredClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredClusterExists(resourceName, &cluster),
+					acctest.CheckResourceDisappears(acctest.Provider, tfeks.ResourceRegisteredCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSRegisteredCluster_tags(t *testing.T) {
+	var cluster1, cluster2, cluster3 eks.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_registered_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRegisteredClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredClusterConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredClusterExists(resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_code"},
+			},
+			{
+				Config: testAccRegisteredClusterConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredClusterExists(resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRegisteredClusterConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredClusterExists(resourceName, &cluster3),
+					testAccCheckClusterNotRecreated(&cluster2, &cluster3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// TestRegisteredCluster_localEndpoint exercises the resource's lifecycle against
+// a local stand-in of the EKS API so that it can run without AWS credentials.
+func TestRegisteredCluster_localEndpoint(t *testing.T) {
+	const (
+		name    = "tf-local-test"
+		roleARN = "arn:aws:iam::123456789012:role/eks-connector-agent" //lintignore:AWSAT005
+	)
+
+	server := httptest.NewServer(newLocalEKSRegistrationHandler(t))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	meta := &conns.AWSClient{EKSConn: eks.New(sess)}
+	ctx := context.Background()
+	r := tfeks.ResourceRegisteredCluster()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": name,
+		"connector_config": []interface{}{
+			map[string]interface{}{
+				"provider": eks.ConnectorConfigProviderOther,
+				"role_arn": roleARN,
+			},
+		},
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	})
+
+	if diags := r.CreateWithoutTimeout(ctx, d, meta); diags.HasError() {
+		t.Fatalf("error creating registered cluster: %v", diags)
+	}
+
+	if got, want := d.Id(), name; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+
+	for attr, want := range map[string]string{
+		"activation_code":             "local-activation-code",
+		"activation_id":               "local-activation-id",
+		"activation_expiry":           "2022-01-02T00:00:00Z",
+		"arn":                         "arn:aws:eks:us-west-2:123456789012:cluster/" + name, //lintignore:AWSAT003,AWSAT005
+		"connector_config.0.provider": eks.ConnectorConfigProviderOther,
+		"connector_config.0.role_arn": roleARN,
+		"status":                      eks.ClusterStatusPending,
+		"tags.key1":                   "value1",
+	} {
+		if got := d.Get(attr).(string); got != want {
+			t.Errorf("%s = %q, want %q", attr, got, want)
+		}
+	}
+
+	if diags := r.DeleteWithoutTimeout(ctx, d, meta); diags.HasError() {
+		t.Fatalf("error deleting registered cluster: %v", diags)
+	}
+
+	if diags := r.ReadWithoutTimeout(ctx, d, meta); diags.HasError() {
+		t.Fatalf("error reading registered cluster: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected registered cluster to be removed from state, got ID %q", d.Id())
+	}
+}
+
+// newLocalEKSRegistrationHandler returns a minimal in-memory implementation of the
+// EKS RegisterCluster, DescribeCluster and DeregisterCluster REST-JSON operations.
+// DescribeCluster deliberately omits the activation code, as the real API may.
+func newLocalEKSRegistrationHandler(t *testing.T) http.Handler {
+	var mu sync.Mutex
+	clusters := map[string]map[string]interface{}{}
+
+	writeJSON := func(w http.ResponseWriter, status int, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			t.Errorf("error encoding response: %s", err)
+		}
+	}
+	notFound := func(w http.ResponseWriter, name string) {
+		w.Header().Set("X-Amzn-Errortype", eks.ErrCodeResourceNotFoundException)
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{
+			"message": fmt.Sprintf("No cluster found for name: %s.", name),
+		})
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/cluster-registrations":
+			var input struct {
+				ConnectorConfig map[string]interface{} `json:"connectorConfig"`
+				Name            string                 `json:"name"`
+				Tags            map[string]interface{} `json:"tags"`
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Errorf("error decoding RegisterCluster request: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			clusters[input.Name] = map[string]interface{}{
+				"arn": "arn:aws:eks:us-west-2:123456789012:cluster/" + input.Name, //lintignore:AWSAT003,AWSAT005
+				"connectorConfig": map[string]interface{}{
+					"activationExpiry": time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC).Unix(),
+					"activationId":     "local-activation-id",
+					"provider":         input.ConnectorConfig["provider"],
+					"roleArn":          input.ConnectorConfig["roleArn"],
+				},
+				"createdAt": time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+				"name":      input.Name,
+				"status":    eks.ClusterStatusPending,
+				"tags":      input.Tags,
+			}
+
+			cluster := map[string]interface{}{}
+			for k, v := range clusters[input.Name] {
+				cluster[k] = v
+			}
+			connectorConfig := map[string]interface{}{"activationCode": "local-activation-code"}
+			for k, v := range clusters[input.Name]["connectorConfig"].(map[string]interface{}) {
+				connectorConfig[k] = v
+			}
+			cluster["connectorConfig"] = connectorConfig
+
+			writeJSON(w, http.StatusOK, map[string]interface{}{"cluster": cluster})
+
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/clusters/"):
+			name := strings.TrimPrefix(r.URL.Path, "/clusters/")
+			cluster, ok := clusters[name]
+
+			if !ok {
+				notFound(w, name)
+				return
+			}
+
+			writeJSON(w, http.StatusOK, map[string]interface{}{"cluster": cluster})
+
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/cluster-registrations/"):
+			name := strings.TrimPrefix(r.URL.Path, "/cluster-registrations/")
+			cluster, ok := clusters[name]
+
+			if !ok {
+				notFound(w, name)
+				return
+			}
+
+			delete(clusters, name)
+			cluster["status"] = eks.ClusterStatusDeleting
+
+			writeJSON(w, http.StatusOK, map[string]interface{}{"cluster": cluster})
+
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	})
+}
+
+func testAccCheckRegisteredClusterExists(n string, v *eks.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EKS Registered Cluster ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+
+		output, err := tfeks.FindClusterByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRegisteredClusterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_eks_registered_cluster" {
+			continue
+		}
+
+		_, err := tfeks.FindClusterByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EKS Registered Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRegisteredClusterConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ssm.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "ssmmessages:CreateControlChannel",
+        "ssmmessages:CreateDataChannel",
+        "ssmmessages:OpenControlChannel",
+        "ssmmessages:OpenDataChannel",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccRegisteredClusterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRegisteredClusterConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_registered_cluster" "test" {
+  name = %[1]q
+
+  connector_config {
+    provider = "OTHER"
+    role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccRegisteredClusterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccRegisteredClusterConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_registered_cluster" "test" {
+  name = %[1]q
+
+  connector_config {
+    provider = "OTHER"
+    role_arn = aws_iam_role.test.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccRegisteredClusterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccRegisteredClusterConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_registered_cluster" "test" {
+  name = %[1]q
+
+  connector_config {
+    provider = "OTHER"
+    role_arn = aws_iam_role.test.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
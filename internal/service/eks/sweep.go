@@ -46,6 +46,11 @@ func init() {
 		Name: "aws_eks_node_group",
 		F:    sweepNodeGroups,
 	})
+
+	resource.AddTestSweepers("aws_eks_registered_cluster", &resource.Sweeper{
+		Name: "aws_eks_registered_cluster",
+		F:    sweepRegisteredClusters,
+	})
 }
 
 func sweepAddon(region string) error {
@@ -356,3 +361,60 @@ func sweepNodeGroups(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepRegisteredClusters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).EKSConn
+	input := &eks.ListClustersInput{
+		Include: aws.StringSlice([]string{"all"}),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListClustersPages(input, func(page *eks.ListClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, name := range page.Clusters {
+			cluster, err := FindClusterByName(conn, aws.StringValue(name))
+
+			if err != nil {
+				log.Printf("[WARN] Skipping EKS Cluster (%s): %s", aws.StringValue(name), err)
+				continue
+			}
+
+			// Only clusters registered through EKS Connector have a connector configuration.
+			if cluster.ConnectorConfig == nil {
+				continue
+			}
+
+			r := ResourceRegisteredCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EKS Registered Clusters sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EKS Registered Clusters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EKS Registered Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
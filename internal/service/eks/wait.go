@@ -14,6 +14,9 @@ const (
 	addonCreatedTimeout = 20 * time.Minute
 	addonUpdatedTimeout = 20 * time.Minute
 	addonDeletedTimeout = 40 * time.Minute
+
+	registeredClusterCreatedTimeout = 10 * time.Minute
+	registeredClusterDeletedTimeout = 10 * time.Minute
 )
 
 func waitAddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
@@ -264,3 +267,38 @@ func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, c
 
 	return nil, err
 }
+
+// A registered cluster remains PENDING until the EKS Connector agent running in it connects.
+func waitRegisteredClusterCreated(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.ClusterStatusCreating},
+		Target:  []string{eks.ClusterStatusPending, eks.ClusterStatusActive},
+		Refresh: statusCluster(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*eks.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitRegisteredClusterDeleted(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.ClusterStatusPending, eks.ClusterStatusActive, eks.ClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: statusCluster(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*eks.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -199,8 +199,9 @@ func ResourceOntapStorageVirtualMachine() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(fsx.StorageVirtualMachineRootVolumeSecurityStyle_Values(), false),
 			},
 			"subtype": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "The subtype attribute is no longer returned by the FSx API",
 			},
 			"svm_admin_password": {
 				Type:         schema.TypeString,
@@ -286,7 +287,6 @@ func resourceOntapStorageVirtualMachineRead(d *schema.ResourceData, meta interfa
 	//RootVolumeSecurityStyle and SVMAdminPassword are write only properties so they don't get returned from the describe API so we just store the original setting to state
 	d.Set("root_volume_security_style", d.Get("root_volume_security_style").(string))
 	d.Set("svm_admin_password", d.Get("svm_admin_password").(string))
-	d.Set("subtype", storageVirtualMachine.Subtype)
 	d.Set("uuid", storageVirtualMachine.UUID)
 
 	if err := d.Set("active_directory_configuration", flattenFsxOntapSvmActiveDirectoryConfiguration(d, storageVirtualMachine.ActiveDirectoryConfiguration)); err != nil {
@@ -62,27 +62,38 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_dir"},
 			},
 			"s3_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"image_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_dir"},
+			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_code_hash"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
 			},
 			"package_type": {
 				Type:         schema.TypeString,
@@ -332,6 +343,7 @@ func ResourceFunction() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			customizeDiffSourceDir,
 			checkHandlerRuntimeForZipFunction,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
@@ -402,9 +414,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 	imageUri, hasImageUri := d.GetOk("image_uri")
+	sourceDir, hasSourceDir := d.GetOk("source_dir")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri {
-		return errors.New("filename, s3_* or image_uri attributes must be set")
+	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri && !hasSourceDir {
+		return errors.New("filename, source_dir, s3_* or image_uri attributes must be set")
 	}
 
 	var functionCode *lambda.FunctionCode
@@ -421,6 +434,16 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		functionCode = &lambda.FunctionCode{
 			ZipFile: file,
 		}
+	} else if hasSourceDir {
+		conns.GlobalMutexKV.Lock(awsMutexLambdaKey)
+		defer conns.GlobalMutexKV.Unlock(awsMutexLambdaKey)
+		file, err := loadSourceDirArchive(d)
+		if err != nil {
+			return fmt.Errorf("unable to package %q: %w", sourceDir.(string), err)
+		}
+		functionCode = &lambda.FunctionCode{
+			ZipFile: file,
+		}
 	} else if hasImageUri {
 		functionCode = &lambda.FunctionCode{
 			ImageUri: aws.String(imageUri.(string)),
@@ -927,6 +950,7 @@ func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
 
 func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
+		d.HasChange("source_dir") ||
 		d.HasChange("source_code_hash") ||
 		d.HasChange("s3_bucket") ||
 		d.HasChange("s3_key") ||
@@ -1167,6 +1191,14 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 				return fmt.Errorf("unable to load %q: %w", v.(string), err)
 			}
 			codeReq.ZipFile = file
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(awsMutexLambdaKey)
+			defer conns.GlobalMutexKV.Unlock(awsMutexLambdaKey)
+			file, err := loadSourceDirArchive(d)
+			if err != nil {
+				return fmt.Errorf("unable to package %q: %w", v.(string), err)
+			}
+			codeReq.ZipFile = file
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			codeReq.ImageUri = aws.String(v.(string))
 		} else {
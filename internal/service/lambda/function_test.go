@@ -2022,6 +2022,91 @@ func TestAccLambdaFunction_Zip_validation(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	resourceName := "aws_lambda_function.test"
+
+	sourceDir := t.TempDir()
+	fileContent, err := os.ReadFile("test-fixtures/lambda_func.js")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_source_dir_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_source_dir_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_source_dir_%s", rString)
+	sgName := fmt.Sprintf("tf_acc_sg_lambda_func_source_dir_%s", rString)
+
+	var sourceCodeHash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLambdaFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filepath.Join(sourceDir, "lambda.js"), fileContent, 0644); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(filepath.Join(sourceDir, "README.md"), []byte("excluded"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, funcName, policyName, roleName, sgName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+					func(s *terraform.State) error {
+						sourceCodeHash = aws.StringValue(conf.Configuration.CodeSha256)
+						return resource.TestCheckResourceAttr(resourceName, "source_code_hash", sourceCodeHash)(s)
+					},
+				),
+			},
+			{
+				// Only file contents contribute to the archive, so touching files and
+				// changing excluded files must not produce a diff.
+				PreConfig: func() {
+					later := time.Now().Add(time.Hour)
+					if err := os.Chtimes(filepath.Join(sourceDir, "lambda.js"), later, later); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(filepath.Join(sourceDir, "README.md"), []byte("still excluded"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccFunctionConfig_sourceDir(sourceDir, funcName, policyName, roleName, sgName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_dir_excludes"},
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filepath.Join(sourceDir, "lambda.js"), append(fileContent, []byte("// modified\n")...), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, funcName, policyName, roleName, sgName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					func(s *terraform.State) error {
+						if v := aws.StringValue(conf.Configuration.CodeSha256); v == sourceCodeHash {
+							return fmt.Errorf("expected Lambda Function code to be updated, hash unchanged: %s", v)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
 
@@ -3213,6 +3298,19 @@ resource "aws_lambda_function" "test" {
 `, funcName)
 }
 
+func testAccFunctionConfig_sourceDir(sourceDir, funcName, policyName, roleName, sgName string) string {
+	return fmt.Sprintf(acctest.ConfigLambdaBase(policyName, roleName, sgName)+`
+resource "aws_lambda_function" "test" {
+  source_dir          = %[1]q
+  source_dir_excludes = ["*.md"]
+  function_name       = %[2]q
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "lambda.handler"
+  runtime             = "nodejs12.x"
+}
+`, sourceDir, funcName)
+}
+
 func testAccFunctionConfig_local(filePath, roleName, funcName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
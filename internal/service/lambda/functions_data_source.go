@@ -0,0 +1,63 @@
+package lambda
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceFunctions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFunctionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"function_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"function_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceFunctionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	var functionARNs, functionNames []string
+
+	err := conn.ListFunctionsPages(&lambda.ListFunctionsInput{}, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Functions {
+			if v == nil {
+				continue
+			}
+
+			functionARNs = append(functionARNs, aws.StringValue(v.FunctionArn))
+			functionNames = append(functionNames, aws.StringValue(v.FunctionName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Lambda Functions: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	d.Set("function_arns", functionARNs)
+	d.Set("function_names", functionNames)
+
+	return nil
+}
@@ -29,6 +29,8 @@ func ResourceLayerVersion() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffSourceDir,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -68,7 +70,7 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "source_dir"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -89,19 +91,19 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -123,6 +125,19 @@ func ResourceLayerVersion() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_code_hash"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -143,9 +158,10 @@ func resourceLayerVersionPublish(d *schema.ResourceData, meta interface{}) error
 	s3Bucket, bucketOk := d.GetOk("s3_bucket")
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
+	sourceDir, hasSourceDir := d.GetOk("source_dir")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return errors.New("filename or s3_* attributes must be set")
+	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasSourceDir {
+		return errors.New("filename, source_dir or s3_* attributes must be set")
 	}
 
 	var layerContent *lambda.LayerVersionContentInput
@@ -159,6 +175,16 @@ func resourceLayerVersionPublish(d *schema.ResourceData, meta interface{}) error
 		layerContent = &lambda.LayerVersionContentInput{
 			ZipFile: file,
 		}
+	} else if hasSourceDir {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+		file, err := loadSourceDirArchive(d)
+		if err != nil {
+			return fmt.Errorf("Unable to package %q: %s", sourceDir.(string), err)
+		}
+		layerContent = &lambda.LayerVersionContentInput{
+			ZipFile: file,
+		}
 	} else {
 		if !bucketOk || !keyOk {
 			return errors.New("s3_bucket and s3_key must all be set while using s3 code source")
@@ -2,8 +2,11 @@ package lambda_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
@@ -275,6 +278,58 @@ func TestAccLambdaLayerVersion_skipDestroy(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_sourceDir(t *testing.T) {
+	resourceName := "aws_lambda_layer_version.lambda_layer_test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	sourceDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(sourceDir, "nodejs"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sourceDir, "nodejs", "index.js"), []byte("exports.example = 1;\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLayerVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionSourceDir(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(resourceName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					later := time.Now().Add(time.Hour)
+					if err := os.Chtimes(filepath.Join(sourceDir, "nodejs", "index.js"), later, later); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccLayerVersionSourceDir(rName, sourceDir),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filepath.Join(sourceDir, "nodejs", "index.js"), []byte("exports.example = 2;\n"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccLayerVersionSourceDir(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(resourceName, rName),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLayerVersionDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
 
@@ -343,6 +398,15 @@ resource "aws_lambda_layer_version" "lambda_layer_test" {
 `, rName)
 }
 
+func testAccLayerVersionSourceDir(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "lambda_layer_test" {
+  layer_name = %[1]q
+  source_dir = %[2]q
+}
+`, rName, sourceDir)
+}
+
 func testAccLayerVersionS3(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "lambda_bucket" {
@@ -0,0 +1,192 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// sourceDirArchiveModTime is recorded as the modification time of every archive entry
+// so that the archive depends only on file names, permissions and contents.
+var sourceDirArchiveModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// buildSourceDirArchive returns a reproducible zip archive of the files below dir.
+// Entries are added in lexical order with fixed timestamps and normalized permissions.
+// Files and directories matching any of the exclude patterns are omitted.
+func buildSourceDirArchive(dir string, excludes []string) ([]byte, error) {
+	root, err := homedir.Expand(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range excludes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern %q: %w", pattern, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	err = filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if sourceDirExcluded(rel, excludes) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		// Symbolic links are archived as the file they point to.
+		info, err := os.Stat(p)
+
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		return addSourceDirArchiveFile(w, p, rel, info.Mode())
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func addSourceDirArchiveFile(w *zip.Writer, p, name string, mode fs.FileMode) error {
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: sourceDirArchiveModTime,
+	}
+
+	if mode&0111 != 0 {
+		header.SetMode(0755)
+	} else {
+		header.SetMode(0644)
+	}
+
+	f, err := os.Open(p)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	fw, err := w.CreateHeader(header)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, f)
+
+	return err
+}
+
+// sourceDirExcluded returns whether the slash-separated relative path matches an exclude pattern.
+// Patterns are matched against the whole relative path, and patterns without a slash
+// are also matched against the path's final element.
+func sourceDirExcluded(rel string, excludes []string) bool {
+	base := path.Base(rel)
+
+	for _, pattern := range excludes {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, base); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// sourceCodeHash returns the base64-encoded SHA256 digest of the deployment package,
+// the same format as the CodeSha256 value returned by the Lambda API.
+func sourceCodeHash(b []byte) string {
+	sum := sha256.Sum256(b)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func loadSourceDirArchive(d interface{ Get(string) interface{} }) ([]byte, error) {
+	var excludes []string
+
+	if v, ok := d.Get("source_dir_excludes").(*schema.Set); ok {
+		for _, v := range v.List() {
+			excludes = append(excludes, v.(string))
+		}
+	}
+
+	return buildSourceDirArchive(d.Get("source_dir").(string), excludes)
+}
+
+// customizeDiffSourceDir packages source_dir at plan time and sets source_code_hash
+// to the hash of the resulting archive, so that only content changes produce a diff.
+func customizeDiffSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	dir, ok := d.GetOk("source_dir")
+
+	if !ok {
+		return nil
+	}
+
+	archive, err := loadSourceDirArchive(d)
+
+	if err != nil {
+		return fmt.Errorf("unable to package source_dir %q: %w", dir.(string), err)
+	}
+
+	if hash := sourceCodeHash(archive); d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildSourceDirArchive(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]os.FileMode{
+		"index.js":                  0600,
+		"bin/run.sh":                0700,
+		"lib/util.js":               0664,
+		"node_modules/dep/index.js": 0644,
+		"README.md":                 0644,
+	}
+
+	for name, mode := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	excludes := []string{"node_modules", "*.md"}
+
+	archive1, err := buildSourceDirArchive(dir, excludes)
+
+	if err != nil {
+		t.Fatalf("error building archive: %s", err)
+	}
+
+	// Touching every file must not change the archive.
+	later := time.Now().Add(time.Hour)
+
+	for name := range files {
+		if err := os.Chtimes(filepath.Join(dir, filepath.FromSlash(name)), later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archive2, err := buildSourceDirArchive(dir, excludes)
+
+	if err != nil {
+		t.Fatalf("error building archive: %s", err)
+	}
+
+	if !bytes.Equal(archive1, archive2) {
+		t.Errorf("expected identical archives, got hashes %s and %s", sourceCodeHash(archive1), sourceCodeHash(archive2))
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(archive1), int64(len(archive1)))
+
+	if err != nil {
+		t.Fatalf("error reading archive: %s", err)
+	}
+
+	var names []string
+	modes := map[string]os.FileMode{}
+
+	for _, f := range r.File {
+		names = append(names, f.Name)
+		modes[f.Name] = f.Mode().Perm()
+
+		if !f.Modified.Equal(sourceDirArchiveModTime) {
+			t.Errorf("%s: modified = %s, want %s", f.Name, f.Modified, sourceDirArchiveModTime)
+		}
+	}
+
+	if want := []string{"bin/run.sh", "index.js", "lib/util.js"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("entries = %v, want %v", names, want)
+	}
+
+	if want := (map[string]os.FileMode{"bin/run.sh": 0755, "index.js": 0644, "lib/util.js": 0644}); !reflect.DeepEqual(modes, want) {
+		t.Errorf("modes = %v, want %v", modes, want)
+	}
+
+	// Changing file contents must change the archive.
+	if err := os.WriteFile(filepath.Join(dir, "index.js"), []byte("modified"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	archive3, err := buildSourceDirArchive(dir, excludes)
+
+	if err != nil {
+		t.Fatalf("error building archive: %s", err)
+	}
+
+	if sourceCodeHash(archive1) == sourceCodeHash(archive3) {
+		t.Error("expected archive hash to change with file contents")
+	}
+}
+
+func TestBuildSourceDirArchive_invalidExclude(t *testing.T) {
+	if _, err := buildSourceDirArchive(t.TempDir(), []string{"["}); err == nil {
+		t.Error("expected error for invalid exclude pattern")
+	}
+}
+
+func TestSourceDirExcluded(t *testing.T) {
+	testCases := []struct {
+		Path     string
+		Excludes []string
+		Expected bool
+	}{
+		{Path: "index.js", Excludes: nil, Expected: false},
+		{Path: "index.js", Excludes: []string{"*.js"}, Expected: true},
+		{Path: "lib/index.js", Excludes: []string{"*.js"}, Expected: true},
+		{Path: "lib/index.js", Excludes: []string{"lib/*.js"}, Expected: true},
+		{Path: "src/lib/index.js", Excludes: []string{"lib/*.js"}, Expected: false},
+		{Path: "tests", Excludes: []string{"tests"}, Expected: true},
+		{Path: "src/tests", Excludes: []string{"tests"}, Expected: true},
+		{Path: "index.ts", Excludes: []string{"*.js", "*.md"}, Expected: false},
+	}
+
+	for _, tc := range testCases {
+		if got := sourceDirExcluded(tc.Path, tc.Excludes); got != tc.Expected {
+			t.Errorf("sourceDirExcluded(%q, %v) = %t, want %t", tc.Path, tc.Excludes, got, tc.Expected)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package memorydb
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceACL() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceACLCreate,
+		ReadWithoutTimeout:   resourceACLRead,
+		UpdateWithoutTimeout: resourceACLUpdate,
+		DeleteWithoutTimeout: resourceACLDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minimum_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validResourceName(40),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validResourceNamePrefix(40),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"user_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.All(validation.StringLenBetween(1, 40), validation.StringDoesNotContainAny(" ")),
+				},
+			},
+		},
+	}
+}
+
+func resourceACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateACLInput{
+		ACLName: aws.String(name),
+		Tags:    Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("user_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.UserNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB ACL: %s", input)
+	_, err := conn.CreateACLWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating MemoryDB ACL (%s): %w", name, err))
+	}
+
+	d.SetId(name)
+
+	if err := waitACLActive(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for MemoryDB ACL (%s) to be created: %w", d.Id(), err))
+	}
+
+	return resourceACLRead(ctx, d, meta)
+}
+
+func resourceACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	acl, err := FindACLByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading MemoryDB ACL (%s): %w", d.Id(), err))
+	}
+
+	d.Set("arn", acl.ARN)
+	d.Set("minimum_engine_version", acl.MinimumEngineVersion)
+	d.Set("name", acl.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(acl.Name)))
+	d.Set("user_names", flex.FlattenStringSet(acl.UserNames))
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for MemoryDB ACL (%s): %w", d.Id(), err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChange("user_names") {
+		o, n := d.GetChange("user_names")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		input := &memorydb.UpdateACLInput{
+			ACLName: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.UserNamesToAdd = flex.ExpandStringSet(add)
+		}
+
+		// When a user is deleted, MemoryDB implicitly removes it from the ACL.
+		// Only remove users that still exist, as removing a missing user is an error.
+		for _, v := range os.Difference(ns).List() {
+			userName := v.(string)
+
+			_, err := FindUserByName(ctx, conn, userName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error reading MemoryDB User (%s): %w", userName, err))
+			}
+
+			input.UserNamesToRemove = append(input.UserNamesToRemove, aws.String(userName))
+		}
+
+		if len(input.UserNamesToAdd) > 0 || len(input.UserNamesToRemove) > 0 {
+			log.Printf("[DEBUG] Updating MemoryDB ACL: %s", input)
+			_, err := conn.UpdateACLWithContext(ctx, input)
+
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error updating MemoryDB ACL (%s): %w", d.Id(), err))
+			}
+
+			if err := waitACLActive(ctx, conn, d.Id()); err != nil {
+				return diag.FromErr(fmt.Errorf("error waiting for MemoryDB ACL (%s) to be modified: %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating MemoryDB ACL (%s) tags: %w", d.Id(), err))
+		}
+	}
+
+	return resourceACLRead(ctx, d, meta)
+}
+
+func resourceACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	log.Printf("[DEBUG] Deleting MemoryDB ACL: %s", d.Id())
+	_, err := conn.DeleteACLWithContext(ctx, &memorydb.DeleteACLInput{
+		ACLName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeACLNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting MemoryDB ACL (%s): %w", d.Id(), err))
+	}
+
+	if err := waitACLDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for MemoryDB ACL (%s) to be deleted: %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,301 @@
+package memorydb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmemorydb "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMemoryDBACL_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "memorydb", "acl/"+rName),
+					resource.TestCheckResourceAttrSet(resourceName, "minimum_engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBACL_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmemorydb.ResourceACL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBACL_nameGenerated(t *testing.T) {
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "aws_memorydb_acl" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					create.TestCheckResourceAttrNameGenerated(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBACL_namePrefix(t *testing.T) {
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "aws_memorydb_acl" "test" {
+  name_prefix = "tftest-"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					create.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tftest-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tftest-"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBACL_userNames(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	user1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	user2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLConfigUserNames(rName, user1, user2, "aws_memorydb_user.test[0].id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccACLConfigUserNames(rName, user1, user2, "aws_memorydb_user.test[0].id, aws_memorydb_user.test[1].id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user2),
+				),
+			},
+			{
+				Config: testAccACLConfigUserNames(rName, user1, user2, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBACL_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccACLConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccACLConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckACLDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_acl" {
+			continue
+		}
+
+		_, err := tfmemorydb.FindACLByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB ACL %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckACLExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB ACL ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+		_, err := tfmemorydb.FindACLByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccACLConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccACLConfigUserNames(rName, user1, user2, userNames string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_user" "test" {
+  count         = 2
+  access_string = "on ~* &* +@all"
+  user_name     = element([%[2]q, %[3]q], count.index)
+
+  authentication_mode {
+    type      = "password"
+    passwords = ["aaaaaaaaaaaaaaaa"]
+  }
+}
+
+resource "aws_memorydb_acl" "test" {
+  name       = %[1]q
+  user_names = [%[4]s]
+}
+`, rName, user1, user2, userNames)
+}
+
+func testAccACLConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccACLConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,432 @@
+package memorydb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmemorydb "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMemoryDBCluster_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "acl_name", "open-access"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "memorydb", "cluster/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_endpoint.0.address"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_endpoint.0.port"),
+					resource.TestCheckResourceAttr(resourceName, "data_tiering", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_patch_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "final_snapshot_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_arn", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "node_type", "db.t4g.small"),
+					resource.TestCheckResourceAttr(resourceName, "num_replicas_per_shard", "1"),
+					resource.TestCheckResourceAttr(resourceName, "num_shards", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameter_group_name"),
+					resource.TestCheckResourceAttr(resourceName, "port", "6379"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "shards.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "shards.0.name"),
+					resource.TestCheckResourceAttr(resourceName, "shards.0.num_nodes", "2"),
+					resource.TestCheckResourceAttr(resourceName, "shards.0.nodes.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "shards.0.slots"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "7"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_window"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_group_name", "aws_memorydb_subnet_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Test", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tls_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBCluster_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmemorydb.ResourceCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBCluster_update_description(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfigDescription(rName, "Test 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterConfigDescription(rName, "Test 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBCluster_update_numShardsAndReplicas(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfigNumShardsAndReplicas(rName, 1, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "num_replicas_per_shard", "0"),
+					resource.TestCheckResourceAttr(resourceName, "num_shards", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shards.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shards.0.num_nodes", "1"),
+				),
+			},
+			{
+				Config: testAccClusterConfigNumShardsAndReplicas(rName, 2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "num_replicas_per_shard", "1"),
+					resource.TestCheckResourceAttr(resourceName, "num_shards", "2"),
+					resource.TestCheckResourceAttr(resourceName, "shards.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "shards.0.num_nodes", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBCluster_finalSnapshotName(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroyWithFinalSnapshot(rName + "-final"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfigFinalSnapshotName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "final_snapshot_name", rName+"-final"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBCluster_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccClusterConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_cluster" {
+			continue
+		}
+
+		_, err := tfmemorydb.FindClusterByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckClusterDestroyWithFinalSnapshot(finalSnapshotName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if err := testAccCheckClusterDestroy(s); err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+		_, err := tfmemorydb.FindSnapshotByName(context.Background(), conn, finalSnapshotName)
+
+		if err != nil {
+			return fmt.Errorf("error reading MemoryDB Cluster final snapshot (%s): %w", finalSnapshotName, err)
+		}
+
+		_, err = conn.DeleteSnapshot(&memorydb.DeleteSnapshotInput{
+			SnapshotName: aws.String(finalSnapshotName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error deleting MemoryDB Cluster final snapshot (%s): %w", finalSnapshotName, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClusterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB Cluster ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+		_, err := tfmemorydb.FindClusterByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccClusterConfigBase(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVpcWithSubnets(2),
+		fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_memorydb_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+`, rName),
+	)
+}
+
+func testAccClusterConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name                 = "open-access"
+  name                     = %[1]q
+  node_type                = "db.t4g.small"
+  num_replicas_per_shard   = 1
+  num_shards               = 2
+  security_group_ids       = [aws_security_group.test.id]
+  snapshot_retention_limit = 7
+  subnet_group_name        = aws_memorydb_subnet_group.test.id
+
+  tags = {
+    Test = "test"
+  }
+}
+`, rName),
+	)
+}
+
+func testAccClusterConfigDescription(rName, description string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name               = "open-access"
+  description            = %[2]q
+  name                   = %[1]q
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  num_shards             = 1
+  subnet_group_name      = aws_memorydb_subnet_group.test.id
+}
+`, rName, description),
+	)
+}
+
+func testAccClusterConfigNumShardsAndReplicas(rName string, numShards, numReplicasPerShard int) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name               = "open-access"
+  name                   = %[1]q
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = %[3]d
+  num_shards             = %[2]d
+  subnet_group_name      = aws_memorydb_subnet_group.test.id
+}
+`, rName, numShards, numReplicasPerShard),
+	)
+}
+
+func testAccClusterConfigFinalSnapshotName(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name               = "open-access"
+  final_snapshot_name    = "%[1]s-final"
+  name                   = %[1]q
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  num_shards             = 1
+  subnet_group_name      = aws_memorydb_subnet_group.test.id
+}
+`, rName),
+	)
+}
+
+func testAccClusterConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name               = "open-access"
+  name                   = %[1]q
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  num_shards             = 1
+  subnet_group_name      = aws_memorydb_subnet_group.test.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1),
+	)
+}
+
+func testAccClusterConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name               = "open-access"
+  name                   = %[1]q
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  num_shards             = 1
+  subnet_group_name      = aws_memorydb_subnet_group.test.id
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2),
+	)
+}
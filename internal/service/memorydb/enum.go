@@ -0,0 +1,47 @@
+package memorydb
+
+const (
+	ACLStatusActive    = "active"
+	ACLStatusCreating  = "creating"
+	ACLStatusDeleting  = "deleting"
+	ACLStatusModifying = "modifying"
+)
+
+const (
+	ClusterStatusAvailable    = "available"
+	ClusterStatusCreating     = "creating"
+	ClusterStatusDeleting     = "deleting"
+	ClusterStatusSnapshotting = "snapshotting"
+	ClusterStatusUpdating     = "updating"
+)
+
+const (
+	ClusterParameterGroupStatusApplying = "applying"
+	ClusterParameterGroupStatusInSync   = "in-sync"
+)
+
+const (
+	ClusterSecurityGroupStatusActive    = "active"
+	ClusterSecurityGroupStatusAdding    = "adding"
+	ClusterSecurityGroupStatusModifying = "modifying"
+	ClusterSecurityGroupStatusRemoving  = "removing"
+)
+
+const (
+	ClusterSNSTopicStatusActive   = "active"
+	ClusterSNSTopicStatusInactive = "inactive"
+)
+
+const (
+	SnapshotStatusAvailable = "available"
+	SnapshotStatusCopying   = "copying"
+	SnapshotStatusCreating  = "creating"
+	SnapshotStatusDeleting  = "deleting"
+	SnapshotStatusRestoring = "restoring"
+)
+
+const (
+	UserStatusActive    = "active"
+	UserStatusDeleting  = "deleting"
+	UserStatusModifying = "modifying"
+)
@@ -0,0 +1,229 @@
+package memorydb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindACLByName(ctx context.Context, conn *memorydb.MemoryDB, name string) (*memorydb.ACL, error) {
+	input := &memorydb.DescribeACLsInput{
+		ACLName: aws.String(name),
+	}
+
+	output, err := conn.DescribeACLsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeACLNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ACLs) == 0 || output.ACLs[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ACLs); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ACLs[0], nil
+}
+
+func FindClusterByName(ctx context.Context, conn *memorydb.MemoryDB, name string) (*memorydb.Cluster, error) {
+	input := &memorydb.DescribeClustersInput{
+		ClusterName:      aws.String(name),
+		ShowShardDetails: aws.Bool(true),
+	}
+
+	output, err := conn.DescribeClustersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Clusters) == 0 || output.Clusters[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Clusters); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Clusters[0], nil
+}
+
+func FindParameterGroupByName(ctx context.Context, conn *memorydb.MemoryDB, name string) (*memorydb.ParameterGroup, error) {
+	input := &memorydb.DescribeParameterGroupsInput{
+		ParameterGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeParameterGroupsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ParameterGroups) == 0 || output.ParameterGroups[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ParameterGroups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ParameterGroups[0], nil
+}
+
+// FindParametersByParameterGroupName returns all the parameters of a parameter group,
+// including the system defaults.
+func FindParametersByParameterGroupName(ctx context.Context, conn *memorydb.MemoryDB, name string) ([]*memorydb.Parameter, error) {
+	input := &memorydb.DescribeParametersInput{
+		ParameterGroupName: aws.String(name),
+	}
+	var parameters []*memorydb.Parameter
+
+	for {
+		output, err := conn.DescribeParametersWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, v := range output.Parameters {
+			if v != nil {
+				parameters = append(parameters, v)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return parameters, nil
+}
+
+func FindSnapshotByName(ctx context.Context, conn *memorydb.MemoryDB, name string) (*memorydb.Snapshot, error) {
+	input := &memorydb.DescribeSnapshotsInput{
+		SnapshotName: aws.String(name),
+		ShowDetail:   aws.Bool(true),
+	}
+
+	output, err := conn.DescribeSnapshotsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeSnapshotNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Snapshots) == 0 || output.Snapshots[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Snapshots); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Snapshots[0], nil
+}
+
+func FindSubnetGroupByName(ctx context.Context, conn *memorydb.MemoryDB, name string) (*memorydb.SubnetGroup, error) {
+	input := &memorydb.DescribeSubnetGroupsInput{
+		SubnetGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeSubnetGroupsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeSubnetGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.SubnetGroups) == 0 || output.SubnetGroups[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.SubnetGroups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.SubnetGroups[0], nil
+}
+
+func FindUserByName(ctx context.Context, conn *memorydb.MemoryDB, name string) (*memorydb.User, error) {
+	input := &memorydb.DescribeUsersInput{
+		UserName: aws.String(name),
+	}
+
+	output, err := conn.DescribeUsersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeUserNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Users) == 0 || output.Users[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Users); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Users[0], nil
+}
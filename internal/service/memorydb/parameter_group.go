@@ -0,0 +1,385 @@
+package memorydb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// parameterGroupMaxParamsPerUpdate is the maximum number of parameters
+// that can be updated or reset in a single API call.
+const parameterGroupMaxParamsPerUpdate = 20
+
+func ResourceParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParameterGroupCreate,
+		ReadWithoutTimeout:   resourceParameterGroupRead,
+		UpdateWithoutTimeout: resourceParameterGroupUpdate,
+		DeleteWithoutTimeout: resourceParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Managed by Terraform",
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validResourceName(255),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validResourceNamePrefix(255),
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: ParameterHash,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateParameterGroupInput{
+		Description:        aws.String(d.Get("description").(string)),
+		Family:             aws.String(d.Get("family").(string)),
+		ParameterGroupName: aws.String(name),
+		Tags:               Tags(tags.IgnoreAWS()),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Parameter Group: %s", input)
+	_, err := conn.CreateParameterGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating MemoryDB Parameter Group (%s): %w", name, err))
+	}
+
+	d.SetId(name)
+
+	// Parameters can only be set once the group exists.
+	if v, ok := d.GetOk("parameter"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateParameterGroupParameters(ctx, conn, d.Id(), expandParameterNameValues(v.(*schema.Set).List())); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err))
+		}
+	}
+
+	return resourceParameterGroupRead(ctx, d, meta)
+}
+
+func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	group, err := FindParameterGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading MemoryDB Parameter Group (%s): %w", d.Id(), err))
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("family", group.Family)
+	d.Set("name", group.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(group.Name)))
+
+	parameters, err := FindParametersByParameterGroupName(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err))
+	}
+
+	// Only include the configured parameters as there are hundreds of system/default ones.
+	if err := d.Set("parameter", flattenParameters(parameters, d.Get("parameter").(*schema.Set).List())); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting parameter: %w", err))
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for MemoryDB Parameter Group (%s): %w", d.Id(), err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		toRemove, toAdd := ParameterChanges(o, n)
+
+		log.Printf("[DEBUG] MemoryDB Parameter Group (%s) parameters to remove: %#v", d.Id(), toRemove)
+		log.Printf("[DEBUG] MemoryDB Parameter Group (%s) parameters to add or update: %#v", d.Id(), toAdd)
+
+		if err := resetParameterGroupParameters(ctx, conn, d.Id(), toRemove); err != nil {
+			return diag.FromErr(fmt.Errorf("error resetting MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err))
+		}
+
+		if err := updateParameterGroupParameters(ctx, conn, d.Id(), toAdd); err != nil {
+			return diag.FromErr(fmt.Errorf("error modifying MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating MemoryDB Parameter Group (%s) tags: %w", d.Id(), err))
+		}
+	}
+
+	return resourceParameterGroupRead(ctx, d, meta)
+}
+
+func resourceParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	log.Printf("[DEBUG] Deleting MemoryDB Parameter Group: %s", d.Id())
+	_, err := conn.DeleteParameterGroupWithContext(ctx, &memorydb.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting MemoryDB Parameter Group (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// resetParameterGroupParameters resets the specified parameters to their defaults,
+// in batches of at most parameterGroupMaxParamsPerUpdate.
+func resetParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	for len(parameters) > 0 {
+		n := len(parameters)
+		if n > parameterGroupMaxParamsPerUpdate {
+			n = parameterGroupMaxParamsPerUpdate
+		}
+
+		var parameterNames []*string
+		for _, parameter := range parameters[:n] {
+			parameterNames = append(parameterNames, parameter.ParameterName)
+		}
+
+		input := &memorydb.ResetParameterGroupInput{
+			ParameterGroupName: aws.String(name),
+			ParameterNames:     parameterNames,
+		}
+
+		log.Printf("[DEBUG] Resetting MemoryDB Parameter Group parameters: %s", input)
+		if _, err := conn.ResetParameterGroupWithContext(ctx, input); err != nil {
+			return err
+		}
+
+		parameters = parameters[n:]
+	}
+
+	return nil
+}
+
+// updateParameterGroupParameters sets the specified parameter values,
+// in batches of at most parameterGroupMaxParamsPerUpdate.
+func updateParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	for len(parameters) > 0 {
+		n := len(parameters)
+		if n > parameterGroupMaxParamsPerUpdate {
+			n = parameterGroupMaxParamsPerUpdate
+		}
+
+		input := &memorydb.UpdateParameterGroupInput{
+			ParameterGroupName:  aws.String(name),
+			ParameterNameValues: parameters[:n],
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Parameter Group parameters: %s", input)
+		if _, err := conn.UpdateParameterGroupWithContext(ctx, input); err != nil {
+			return err
+		}
+
+		parameters = parameters[n:]
+	}
+
+	return nil
+}
+
+func ParameterHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["value"].(string)))
+
+	return create.StringHashcode(buf.String())
+}
+
+// ParameterChanges returns the parameters to reset and the parameters to add or update
+// between the old and new values of the parameter set.
+func ParameterChanges(o, n interface{}) (remove, addOrUpdate []*memorydb.ParameterNameValue) {
+	if o == nil {
+		o = new(schema.Set)
+	}
+	if n == nil {
+		n = new(schema.Set)
+	}
+
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	om := make(map[string]*memorydb.ParameterNameValue, os.Len())
+	for _, raw := range os.List() {
+		param := raw.(map[string]interface{})
+		om[param["name"].(string)] = expandParameterNameValue(param)
+	}
+	nm := make(map[string]*memorydb.ParameterNameValue, ns.Len())
+	for _, raw := range ns.List() {
+		param := raw.(map[string]interface{})
+		nm[param["name"].(string)] = expandParameterNameValue(param)
+	}
+
+	// Remove: key is in old, but not in new
+	remove = make([]*memorydb.ParameterNameValue, 0, os.Len())
+	for k := range om {
+		if _, ok := nm[k]; !ok {
+			remove = append(remove, om[k])
+		}
+	}
+
+	// Add or Update: key is in new, but not in old or has changed value
+	addOrUpdate = make([]*memorydb.ParameterNameValue, 0, ns.Len())
+	for k, nv := range nm {
+		ov, ok := om[k]
+		if !ok || aws.StringValue(nv.ParameterValue) != aws.StringValue(ov.ParameterValue) {
+			addOrUpdate = append(addOrUpdate, nm[k])
+		}
+	}
+
+	return remove, addOrUpdate
+}
+
+func expandParameterNameValue(tfMap map[string]interface{}) *memorydb.ParameterNameValue {
+	return &memorydb.ParameterNameValue{
+		ParameterName:  aws.String(tfMap["name"].(string)),
+		ParameterValue: aws.String(tfMap["value"].(string)),
+	}
+}
+
+func expandParameterNameValues(tfList []interface{}) []*memorydb.ParameterNameValue {
+	result := make([]*memorydb.ParameterNameValue, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		result = append(result, expandParameterNameValue(tfMap))
+	}
+
+	return result
+}
+
+// flattenParameters returns the parameters whose names appear in the configured list.
+func flattenParameters(apiObjects []*memorydb.Parameter, configured []interface{}) []interface{} {
+	names := make(map[string]struct{}, len(configured))
+
+	for _, tfMapRaw := range configured {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			names[tfMap["name"].(string)] = struct{}{}
+		}
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		name := aws.StringValue(apiObject.Name)
+
+		if _, ok := names[name]; !ok {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  name,
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
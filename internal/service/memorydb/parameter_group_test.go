@@ -0,0 +1,278 @@
+package memorydb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmemorydb "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMemoryDBParameterGroup_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "memorydb", "parametergroup/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "family", "memorydb_redis6"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBParameterGroup_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmemorydb.ResourceParameterGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBParameterGroup_parameters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfigParameters2(rName, "timeout", "0", "activedefrag", "yes"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "timeout",
+						"value": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "activedefrag",
+						"value": "yes",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParameterGroupConfigParameters1(rName, "timeout", "60"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "timeout",
+						"value": "60",
+					}),
+				),
+			},
+			{
+				Config: testAccParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBParameterGroup_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParameterGroupConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccParameterGroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParameterGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_parameter_group" {
+			continue
+		}
+
+		_, err := tfmemorydb.FindParameterGroupByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB Parameter Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckParameterGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB Parameter Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+		_, err := tfmemorydb.FindParameterGroupByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccParameterGroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+}
+`, rName)
+}
+
+func testAccParameterGroupConfigParameters1(rName, paramName1, paramValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = %[2]q
+    value = %[3]q
+  }
+}
+`, rName, paramName1, paramValue1)
+}
+
+func testAccParameterGroupConfigParameters2(rName, paramName1, paramValue1, paramName2, paramValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = %[2]q
+    value = %[3]q
+  }
+
+  parameter {
+    name  = %[4]q
+    value = %[5]q
+  }
+}
+`, rName, paramName1, paramValue1, paramName2, paramValue2)
+}
+
+func testAccParameterGroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccParameterGroupConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
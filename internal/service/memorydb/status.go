@@ -0,0 +1,114 @@
+package memorydb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusACL(ctx context.Context, conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		acl, err := FindACLByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return acl, aws.StringValue(acl.Status), nil
+	}
+}
+
+func statusCluster(ctx context.Context, conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := FindClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.StringValue(cluster.Status), nil
+	}
+}
+
+func statusClusterParameterGroup(ctx context.Context, conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := FindClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.StringValue(cluster.ParameterGroupStatus), nil
+	}
+}
+
+// statusClusterSecurityGroups returns the status of the first security group
+// that is not active, or "active" when all of the cluster's security groups are active.
+func statusClusterSecurityGroups(ctx context.Context, conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := FindClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, sg := range cluster.SecurityGroups {
+			if status := aws.StringValue(sg.Status); status != ClusterSecurityGroupStatusActive {
+				return cluster, status, nil
+			}
+		}
+
+		return cluster, ClusterSecurityGroupStatusActive, nil
+	}
+}
+
+func statusSnapshot(ctx context.Context, conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := FindSnapshotByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return snapshot, aws.StringValue(snapshot.Status), nil
+	}
+}
+
+func statusUser(ctx context.Context, conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		user, err := FindUserByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return user, aws.StringValue(user.Status), nil
+	}
+}
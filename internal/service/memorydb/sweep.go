@@ -0,0 +1,368 @@
+//go:build sweep
+// +build sweep
+
+package memorydb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
+		Name: "aws_memorydb_acl",
+		F:    sweepACLs,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
+		Name: "aws_memorydb_cluster",
+		F:    sweepClusters,
+	})
+
+	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
+		Name: "aws_memorydb_parameter_group",
+		F:    sweepParameterGroups,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
+		Name: "aws_memorydb_snapshot",
+		F:    sweepSnapshots,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
+		Name: "aws_memorydb_subnet_group",
+		F:    sweepSubnetGroups,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
+		Name: "aws_memorydb_user",
+		F:    sweepUsers,
+		Dependencies: []string{
+			"aws_memorydb_acl",
+		},
+	})
+}
+
+func sweepACLs(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeACLsInput{}
+
+	for {
+		output, err := conn.DescribeACLs(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB ACLs for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range output.ACLs {
+			if aws.StringValue(v.Name) == "open-access" {
+				continue // The open-access ACL cannot be deleted.
+			}
+
+			r := ResourceACL()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB ACLs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB ACLs sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepClusters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeClustersInput{}
+
+	for {
+		output, err := conn.DescribeClusters(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Clusters for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range output.Clusters {
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Clusters for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB Clusters sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepParameterGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeParameterGroupsInput{}
+
+	for {
+		output, err := conn.DescribeParameterGroups(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Parameter Groups for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range output.ParameterGroups {
+			if strings.HasPrefix(aws.StringValue(v.Name), "default.") {
+				continue // Default parameter groups cannot be deleted.
+			}
+
+			r := ResourceParameterGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Parameter Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB Parameter Groups sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepSnapshots(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeSnapshotsInput{}
+
+	for {
+		output, err := conn.DescribeSnapshots(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Snapshots for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range output.Snapshots {
+			r := ResourceSnapshot()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Snapshots for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB Snapshots sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepSubnetGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeSubnetGroupsInput{}
+
+	for {
+		output, err := conn.DescribeSubnetGroups(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Subnet Groups for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range output.SubnetGroups {
+			if aws.StringValue(v.Name) == "default" {
+				continue // The default subnet group cannot be deleted.
+			}
+
+			r := ResourceSubnetGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Subnet Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB Subnet Groups sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepUsers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeUsersInput{}
+
+	for {
+		output, err := conn.DescribeUsers(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Users for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range output.Users {
+			if aws.StringValue(v.Name) == "default" {
+				continue // The default user cannot be deleted.
+			}
+
+			r := ResourceUser()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Users for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB Users sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,32 @@
+package memorydb
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validResourceName returns a validation function for the names of MemoryDB
+// resources: lowercase letters, digits and hyphens, beginning with a letter,
+// without consecutive hyphens and not ending with a hyphen.
+func validResourceName(maxLength int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLength),
+		validation.StringMatch(regexp.MustCompile(`^[a-z0-9-]+$`), "must contain only lowercase alphanumeric characters and hyphens"),
+		validation.StringMatch(regexp.MustCompile(`^[a-z]`), "must begin with a lowercase letter"),
+		validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+		validation.StringDoesNotMatch(regexp.MustCompile(`-$`), "cannot end with a hyphen"),
+	)
+}
+
+// validResourceNamePrefix is the name_prefix counterpart of validResourceName.
+func validResourceNamePrefix(maxLength int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLength-resource.UniqueIDSuffixLength),
+		validation.StringMatch(regexp.MustCompile(`^[a-z0-9-]+$`), "must contain only lowercase alphanumeric characters and hyphens"),
+		validation.StringMatch(regexp.MustCompile(`^[a-z]`), "must begin with a lowercase letter"),
+		validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+	)
+}
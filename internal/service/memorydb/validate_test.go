@@ -0,0 +1,65 @@
+package memorydb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidResourceName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"tf-test",
+		"tf-test-1",
+		strings.Repeat("a", 40),
+	}
+
+	for _, v := range validNames {
+		if _, errors := validResourceName(40)(v, "name"); len(errors) != 0 {
+			t.Errorf("%q should be a valid name: %v", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1tf-test",
+		"-tf-test",
+		"tf-test-",
+		"tf--test",
+		"TF-test",
+		"tf_test",
+		strings.Repeat("a", 41),
+	}
+
+	for _, v := range invalidNames {
+		if _, errors := validResourceName(40)(v, "name"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid name", v)
+		}
+	}
+}
+
+func TestValidResourceNamePrefix(t *testing.T) {
+	validPrefixes := []string{
+		"a",
+		"tf-test-",
+		strings.Repeat("a", 14),
+	}
+
+	for _, v := range validPrefixes {
+		if _, errors := validResourceNamePrefix(40)(v, "name_prefix"); len(errors) != 0 {
+			t.Errorf("%q should be a valid name prefix: %v", v, errors)
+		}
+	}
+
+	invalidPrefixes := []string{
+		"",
+		"1tf-test",
+		"tf--test",
+		strings.Repeat("a", 15),
+	}
+
+	for _, v := range invalidPrefixes {
+		if _, errors := validResourceNamePrefix(40)(v, "name_prefix"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid name prefix", v)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package memorydb
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	aclActiveTimeout  = 5 * time.Minute
+	aclDeletedTimeout = 5 * time.Minute
+
+	clusterParameterGroupInSyncTimeout = 60 * time.Minute
+	clusterSecurityGroupsActiveTimeout = 10 * time.Minute
+
+	userActiveTimeout  = 5 * time.Minute
+	userDeletedTimeout = 5 * time.Minute
+)
+
+func waitACLActive(ctx context.Context, conn *memorydb.MemoryDB, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ACLStatusCreating, ACLStatusModifying},
+		Target:  []string{ACLStatusActive},
+		Refresh: statusACL(ctx, conn, name),
+		Timeout: aclActiveTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitACLDeleted(ctx context.Context, conn *memorydb.MemoryDB, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ACLStatusDeleting},
+		Target:  []string{},
+		Refresh: statusACL(ctx, conn, name),
+		Timeout: aclDeletedTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitClusterAvailable(ctx context.Context, conn *memorydb.MemoryDB, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterStatusCreating, ClusterStatusUpdating, ClusterStatusSnapshotting},
+		Target:  []string{ClusterStatusAvailable},
+		Refresh: statusCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *memorydb.MemoryDB, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: statusCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitClusterParameterGroupInSync(ctx context.Context, conn *memorydb.MemoryDB, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterParameterGroupStatusApplying},
+		Target:  []string{ClusterParameterGroupStatusInSync},
+		Refresh: statusClusterParameterGroup(ctx, conn, name),
+		Timeout: clusterParameterGroupInSyncTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.MemoryDB, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterSecurityGroupStatusAdding, ClusterSecurityGroupStatusModifying, ClusterSecurityGroupStatusRemoving},
+		Target:  []string{ClusterSecurityGroupStatusActive},
+		Refresh: statusClusterSecurityGroups(ctx, conn, name),
+		Timeout: clusterSecurityGroupsActiveTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitSnapshotAvailable(ctx context.Context, conn *memorydb.MemoryDB, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{SnapshotStatusCreating},
+		Target:  []string{SnapshotStatusAvailable},
+		Refresh: statusSnapshot(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitSnapshotDeleted(ctx context.Context, conn *memorydb.MemoryDB, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{SnapshotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusSnapshot(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitUserActive(ctx context.Context, conn *memorydb.MemoryDB, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusModifying},
+		Target:  []string{UserStatusActive},
+		Refresh: statusUser(ctx, conn, name),
+		Timeout: userActiveTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitUserDeleted(ctx context.Context, conn *memorydb.MemoryDB, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusDeleting},
+		Target:  []string{},
+		Refresh: statusUser(ctx, conn, name),
+		Timeout: userDeletedTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -0,0 +1,132 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceClusterActivityStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClusterActivityStreamCreate,
+		Read:   resourceClusterActivityStreamRead,
+		Delete: resourceClusterActivityStreamDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"engine_native_audit_fields_included": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"kinesis_stream_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rds.ActivityStreamMode_Values(), false),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceClusterActivityStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	arn := d.Get("resource_arn").(string)
+	input := &rds.StartActivityStreamInput{
+		ApplyImmediately:                aws.Bool(true),
+		EngineNativeAuditFieldsIncluded: aws.Bool(d.Get("engine_native_audit_fields_included").(bool)),
+		KmsKeyId:                        aws.String(d.Get("kms_key_id").(string)),
+		Mode:                            aws.String(d.Get("mode").(string)),
+		ResourceArn:                     aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Starting RDS Cluster Activity Stream: %s", input)
+	_, err := conn.StartActivityStream(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting RDS Cluster (%s) Activity Stream: %w", arn, err)
+	}
+
+	d.SetId(arn)
+
+	if _, err := waitDBClusterActivityStreamStarted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for RDS Cluster (%s) Activity Stream start: %w", d.Id(), err)
+	}
+
+	return resourceClusterActivityStreamRead(d, meta)
+}
+
+func resourceClusterActivityStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	dbCluster, err := FindDBClusterWithActivityStream(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Cluster (%s) Activity Stream not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Cluster (%s) Activity Stream: %w", d.Id(), err)
+	}
+
+	d.Set("kinesis_stream_name", dbCluster.ActivityStreamKinesisStreamName)
+	d.Set("kms_key_id", dbCluster.ActivityStreamKmsKeyId)
+	d.Set("mode", dbCluster.ActivityStreamMode)
+	d.Set("resource_arn", dbCluster.DBClusterArn)
+
+	return nil
+}
+
+func resourceClusterActivityStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	log.Printf("[DEBUG] Stopping RDS Cluster Activity Stream: %s", d.Id())
+	_, err := conn.StopActivityStream(&rds.StopActivityStreamInput{
+		ApplyImmediately: aws.Bool(true),
+		ResourceArn:      aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping RDS Cluster (%s) Activity Stream: %w", d.Id(), err)
+	}
+
+	if _, err := waitDBClusterActivityStreamStopped(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for RDS Cluster (%s) Activity Stream stop: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,307 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomDBEngineVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomDBEngineVersionCreate,
+		Read:   resourceCustomDBEngineVersionRead,
+		Update: resourceCustomDBEngineVersionUpdate,
+		Delete: resourceCustomDBEngineVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_installation_files_s3_bucket_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"database_installation_files_s3_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"db_parameter_group_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 35),
+					validation.StringMatch(regexp.MustCompile(`^custom-`), "must be an RDS Custom engine"),
+				),
+			},
+			"engine_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"major_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(1, 51000), validation.StringIsJSON),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			"manifest_computed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(rds.CustomEngineVersionStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCustomDBEngineVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	engine := d.Get("engine").(string)
+	engineVersion := d.Get("engine_version").(string)
+	id := CustomDBEngineVersionCreateResourceID(engine, engineVersion)
+	input := &rds.CreateCustomDBEngineVersionInput{
+		DatabaseInstallationFilesS3BucketName: aws.String(d.Get("database_installation_files_s3_bucket_name").(string)),
+		Engine:                                aws.String(engine),
+		EngineVersion:                         aws.String(engineVersion),
+		KMSKeyId:                              aws.String(d.Get("kms_key_id").(string)),
+		Manifest:                              aws.String(d.Get("manifest").(string)),
+		Tags:                                  Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("database_installation_files_s3_prefix"); ok {
+		input.DatabaseInstallationFilesS3Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating RDS Custom DB Engine Version: %s", input)
+	_, err := conn.CreateCustomDBEngineVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating RDS Custom DB Engine Version (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitCustomDBEngineVersionCreated(conn, engine, engineVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for RDS Custom DB Engine Version (%s) create: %w", d.Id(), err)
+	}
+
+	// New custom engine versions are always created as available.
+	if v, ok := d.GetOk("status"); ok && v.(string) != CustomDBEngineVersionStatusAvailable {
+		if err := modifyCustomDBEngineVersion(conn, engine, engineVersion, &rds.ModifyCustomDBEngineVersionInput{Status: aws.String(v.(string))}, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomDBEngineVersionRead(d, meta)
+}
+
+func resourceCustomDBEngineVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindCustomDBEngineVersion(conn, engine, engineVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Custom DB Engine Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Custom DB Engine Version (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.DBEngineVersionArn)
+	d.Set("arn", arn)
+	if output.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("database_installation_files_s3_bucket_name", output.DatabaseInstallationFilesS3BucketName)
+	d.Set("database_installation_files_s3_prefix", output.DatabaseInstallationFilesS3Prefix)
+	d.Set("db_parameter_group_family", output.DBParameterGroupFamily)
+	d.Set("description", output.DBEngineVersionDescription)
+	d.Set("engine", output.Engine)
+	d.Set("engine_version", output.EngineVersion)
+	d.Set("kms_key_id", output.KMSKeyId)
+	d.Set("major_engine_version", output.MajorEngineVersion)
+	// RDS adds its own fields to the manifest, so only populate the configured value on import.
+	if _, ok := d.GetOk("manifest"); !ok {
+		d.Set("manifest", output.CustomDBEngineVersionManifest)
+	}
+	d.Set("manifest_computed", output.CustomDBEngineVersionManifest)
+	d.Set("status", output.Status)
+
+	tags := KeyValueTags(output.TagList).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCustomDBEngineVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("description", "status") {
+		input := &rds.ModifyCustomDBEngineVersionInput{}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if err := modifyCustomDBEngineVersion(conn, engine, engineVersion, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating RDS Custom DB Engine Version (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCustomDBEngineVersionRead(d, meta)
+}
+
+func resourceCustomDBEngineVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Custom DB Engine Version: %s", d.Id())
+	_, err = conn.DeleteCustomDBEngineVersion(&rds.DeleteCustomDBEngineVersionInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCustomDBEngineVersionNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RDS Custom DB Engine Version (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitCustomDBEngineVersionDeleted(conn, engine, engineVersion, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for RDS Custom DB Engine Version (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func modifyCustomDBEngineVersion(conn *rds.RDS, engine, engineVersion string, input *rds.ModifyCustomDBEngineVersionInput, timeout time.Duration) error {
+	id := CustomDBEngineVersionCreateResourceID(engine, engineVersion)
+	input.Engine = aws.String(engine)
+	input.EngineVersion = aws.String(engineVersion)
+
+	log.Printf("[DEBUG] Updating RDS Custom DB Engine Version: %s", input)
+	_, err := conn.ModifyCustomDBEngineVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating RDS Custom DB Engine Version (%s): %w", id, err)
+	}
+
+	if _, err := waitCustomDBEngineVersionUpdated(conn, engine, engineVersion, timeout); err != nil {
+		return fmt.Errorf("error waiting for RDS Custom DB Engine Version (%s) update: %w", id, err)
+	}
+
+	return nil
+}
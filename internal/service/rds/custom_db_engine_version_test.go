@@ -0,0 +1,332 @@
+package rds_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The Oracle installation media is licensed and must be uploaded to an S3 bucket beforehand.
+const envVarCustomDBEngineVersionS3Bucket = "RDS_CUSTOM_ORACLE_S3_BUCKET"
+
+func testAccPreCheckCustomDBEngineVersion(t *testing.T) string {
+	bucket := os.Getenv(envVarCustomDBEngineVersionS3Bucket)
+
+	if bucket == "" {
+		t.Skipf("Environment variable %s is not set", envVarCustomDBEngineVersionS3Bucket)
+	}
+
+	return bucket
+}
+
+func TestAccRDSCustomDBEngineVersion_basic(t *testing.T) {
+	bucket := testAccPreCheckCustomDBEngineVersion(t)
+	var v rds.DBEngineVersion
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	engineVersion := fmt.Sprintf("19.%s", rName)
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomDBEngineVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig(rName, bucket, engineVersion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`cev:custom-oracle-ee/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "database_installation_files_s3_bucket_name", bucket),
+					resource.TestCheckResourceAttrSet(resourceName, "db_parameter_group_family"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "engine", "custom-oracle-ee"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", engineVersion),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "major_engine_version", "19"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_computed"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.CustomDBEngineVersionStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+		},
+	})
+}
+
+func TestAccRDSCustomDBEngineVersion_disappears(t *testing.T) {
+	bucket := testAccPreCheckCustomDBEngineVersion(t)
+	var v rds.DBEngineVersion
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	engineVersion := fmt.Sprintf("19.%s", rName)
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomDBEngineVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig(rName, bucket, engineVersion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrds.ResourceCustomDBEngineVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSCustomDBEngineVersion_descriptionAndStatus(t *testing.T) {
+	bucket := testAccPreCheckCustomDBEngineVersion(t)
+	var v rds.DBEngineVersion
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	engineVersion := fmt.Sprintf("19.%s", rName)
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomDBEngineVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionDescriptionAndStatusConfig(rName, bucket, engineVersion, "description 1", tfrds.CustomDBEngineVersionStatusInactive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.CustomDBEngineVersionStatusInactive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+			{
+				Config: testAccCustomDBEngineVersionDescriptionAndStatusConfig(rName, bucket, engineVersion, "description 2", tfrds.CustomDBEngineVersionStatusAvailable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.CustomDBEngineVersionStatusAvailable),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCustomDBEngineVersion_tags(t *testing.T) {
+	bucket := testAccPreCheckCustomDBEngineVersion(t)
+	var v rds.DBEngineVersion
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	engineVersion := fmt.Sprintf("19.%s", rName)
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomDBEngineVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionTags1Config(rName, bucket, engineVersion, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+			{
+				Config: testAccCustomDBEngineVersionTags2Config(rName, bucket, engineVersion, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCustomDBEngineVersionTags1Config(rName, bucket, engineVersion, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomDBEngineVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rds_custom_db_engine_version" {
+			continue
+		}
+
+		engine, engineVersion, err := tfrds.CustomDBEngineVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfrds.FindCustomDBEngineVersion(conn, engine, engineVersion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RDS Custom DB Engine Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCustomDBEngineVersionExists(n string, v *rds.DBEngineVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Custom DB Engine Version ID is set")
+		}
+
+		engine, engineVersion, err := tfrds.CustomDBEngineVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindCustomDBEngineVersion(conn, engine, engineVersion)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCustomDBEngineVersionBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+`, rName)
+}
+
+const testAccCustomDBEngineVersionManifest = `{
+  "mediaImportTemplateVersion": "2020-08-14",
+  "databaseInstallationFileNames": ["V982063-01.zip"],
+  "opatchFileNames": ["p6880880_190000_Linux-x86-64.zip"],
+  "psuRuPatchFileNames": ["p32126828_190000_Linux-x86-64.zip"],
+  "otherPatchFileNames": []
+}`
+
+func testAccCustomDBEngineVersionConfig(rName, bucket, engineVersion string) string {
+	return acctest.ConfigCompose(testAccCustomDBEngineVersionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rds_custom_db_engine_version" "test" {
+  database_installation_files_s3_bucket_name = %[1]q
+  engine                                     = "custom-oracle-ee"
+  engine_version                             = %[2]q
+  kms_key_id                                 = aws_kms_key.test.arn
+
+  manifest = <<EOT
+%[3]s
+EOT
+}
+`, bucket, engineVersion, testAccCustomDBEngineVersionManifest))
+}
+
+func testAccCustomDBEngineVersionDescriptionAndStatusConfig(rName, bucket, engineVersion, description, status string) string {
+	return acctest.ConfigCompose(testAccCustomDBEngineVersionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rds_custom_db_engine_version" "test" {
+  database_installation_files_s3_bucket_name = %[1]q
+  description                                = %[4]q
+  engine                                     = "custom-oracle-ee"
+  engine_version                             = %[2]q
+  kms_key_id                                 = aws_kms_key.test.arn
+  status                                     = %[5]q
+
+  manifest = <<EOT
+%[3]s
+EOT
+}
+`, bucket, engineVersion, testAccCustomDBEngineVersionManifest, description, status))
+}
+
+func testAccCustomDBEngineVersionTags1Config(rName, bucket, engineVersion, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccCustomDBEngineVersionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rds_custom_db_engine_version" "test" {
+  database_installation_files_s3_bucket_name = %[1]q
+  engine                                     = "custom-oracle-ee"
+  engine_version                             = %[2]q
+  kms_key_id                                 = aws_kms_key.test.arn
+
+  manifest = <<EOT
+%[3]s
+EOT
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, bucket, engineVersion, testAccCustomDBEngineVersionManifest, tagKey1, tagValue1))
+}
+
+func testAccCustomDBEngineVersionTags2Config(rName, bucket, engineVersion, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCustomDBEngineVersionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rds_custom_db_engine_version" "test" {
+  database_installation_files_s3_bucket_name = %[1]q
+  engine                                     = "custom-oracle-ee"
+  engine_version                             = %[2]q
+  kms_key_id                                 = aws_kms_key.test.arn
+
+  manifest = <<EOT
+%[3]s
+EOT
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, bucket, engineVersion, testAccCustomDBEngineVersionManifest, tagKey1, tagValue1, tagKey2, tagValue2))
+}
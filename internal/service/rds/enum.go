@@ -58,3 +58,36 @@ func ExportableLogType_Values() []string {
 		ExportableLogTypeUpgrade,
 	}
 }
+
+const (
+	DBSnapshotStatusAvailable = "available"
+	DBSnapshotStatusCopying   = "copying"
+	DBSnapshotStatusCreating  = "creating"
+	DBSnapshotStatusPending   = "pending"
+)
+
+const (
+	InstanceAutomatedBackupStatusPending     = "pending"
+	InstanceAutomatedBackupStatusReplicating = "replicating"
+	InstanceAutomatedBackupStatusRetained    = "retained"
+)
+
+// https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_ExportTask.html.
+const (
+	ExportTaskStatusCanceled   = "CANCELED"
+	ExportTaskStatusCanceling  = "CANCELING"
+	ExportTaskStatusComplete   = "COMPLETE"
+	ExportTaskStatusFailed     = "FAILED"
+	ExportTaskStatusInProgress = "IN_PROGRESS"
+	ExportTaskStatusStarting   = "STARTING"
+)
+
+const (
+	CustomDBEngineVersionStatusAvailable             = "available"
+	CustomDBEngineVersionStatusCreating              = "creating"
+	CustomDBEngineVersionStatusDeleting              = "deleting"
+	CustomDBEngineVersionStatusFailed                = "failed"
+	CustomDBEngineVersionStatusInactive              = "inactive"
+	CustomDBEngineVersionStatusInactiveExceptRestore = "inactive-except-restore"
+	CustomDBEngineVersionStatusPendingValidation     = "pending-validation"
+)
@@ -189,3 +189,200 @@ func FindEventSubscriptionByID(conn *rds.RDS, id string) (*rds.EventSubscription
 
 	return output.EventSubscriptionsList[0], nil
 }
+
+func FindDBSnapshotByID(conn *rds.RDS, id string) (*rds.DBSnapshot, error) {
+	input := &rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeDBSnapshots(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBSnapshotNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBSnapshots) == 0 || output.DBSnapshots[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DBSnapshots); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	dbSnapshot := output.DBSnapshots[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(dbSnapshot.DBSnapshotIdentifier) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return dbSnapshot, nil
+}
+
+func FindDBInstanceAutomatedBackupByARN(conn *rds.RDS, arn string) (*rds.DBInstanceAutomatedBackup, error) {
+	input := &rds.DescribeDBInstanceAutomatedBackupsInput{
+		DBInstanceAutomatedBackupsArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDBInstanceAutomatedBackups(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceAutomatedBackupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBInstanceAutomatedBackups) == 0 || output.DBInstanceAutomatedBackups[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DBInstanceAutomatedBackups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	backup := output.DBInstanceAutomatedBackups[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(backup.DBInstanceAutomatedBackupsArn) != arn {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return backup, nil
+}
+
+// FindDBInstanceAutomatedBackupsReplication returns the automated backups
+// replication with the specified ARN from the source DB instance.
+func FindDBInstanceAutomatedBackupsReplication(conn *rds.RDS, dbInstanceID, backupARN string) (*rds.DBInstanceAutomatedBackupsReplication, error) {
+	dbInstance, err := FindDBInstanceByID(conn, dbInstanceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, replication := range dbInstance.DBInstanceAutomatedBackupsReplications {
+		if aws.StringValue(replication.DBInstanceAutomatedBackupsArn) == backupARN {
+			return replication, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+// FindDBClusterWithActivityStream returns the DB cluster with the specified ARN
+// if it has a database activity stream that has not been stopped.
+func FindDBClusterWithActivityStream(conn *rds.RDS, arn string) (*rds.DBCluster, error) {
+	input := &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDBClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBClusters) == 0 || output.DBClusters[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	dbCluster := output.DBClusters[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(dbCluster.DBClusterArn) != arn {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if status := aws.StringValue(dbCluster.ActivityStreamStatus); status == rds.ActivityStreamStatusStopped {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return dbCluster, nil
+}
+
+func FindExportTaskByID(conn *rds.RDS, id string) (*rds.ExportTask, error) {
+	input := &rds.DescribeExportTasksInput{
+		ExportTaskIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeExportTasks(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeExportTaskNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ExportTasks) == 0 || output.ExportTasks[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ExportTasks); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ExportTasks[0], nil
+}
+
+func FindCustomDBEngineVersion(conn *rds.RDS, engine, engineVersion string) (*rds.DBEngineVersion, error) {
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+		IncludeAll:    aws.Bool(true),
+	}
+
+	output, err := conn.DescribeDBEngineVersions(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCustomDBEngineVersionNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBEngineVersions) == 0 || output.DBEngineVersions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DBEngineVersions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.DBEngineVersions[0], nil
+}
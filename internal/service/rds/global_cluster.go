@@ -3,9 +3,11 @@ package rds
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 const (
@@ -29,6 +32,10 @@ func ResourceGlobalCluster() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -90,6 +97,12 @@ func ResourceGlobalCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"primary_cluster_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"source_db_cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -201,6 +214,11 @@ func resourceGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
 	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
 
+	// Until a DB Cluster joins the Global Cluster there is no writer, so keep the configured value.
+	if v := globalClusterWriterARN(globalCluster); v != "" {
+		d.Set("primary_cluster_identifier", v)
+	}
+
 	return nil
 }
 
@@ -233,6 +251,12 @@ func resourceGlobalClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error waiting for RDS Global Cluster (%s) update: %s", d.Id(), err)
 	}
 
+	if d.HasChange("primary_cluster_identifier") {
+		if err := resourceGlobalClusterFailover(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceGlobalClusterRead(d, meta)
 }
 
@@ -328,6 +352,92 @@ func resourceGlobalClusterDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceGlobalClusterFailover(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+	targetARN := d.Get("primary_cluster_identifier").(string)
+
+	globalCluster, err := DescribeGlobalCluster(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Global Cluster (%s): %w", d.Id(), err)
+	}
+
+	if globalCluster == nil {
+		return fmt.Errorf("error reading RDS Global Cluster (%s): not found", d.Id())
+	}
+
+	// There is nothing to fail over to until the DB Clusters have joined the Global Cluster.
+	if len(globalCluster.GlobalClusterMembers) == 0 {
+		log.Printf("[DEBUG] RDS Global Cluster (%s) has no members, skipping failover", d.Id())
+		return nil
+	}
+
+	// The requested cluster may already be the primary, e.g. after an unplanned failover.
+	if globalClusterWriterARN(globalCluster) == targetARN {
+		return nil
+	}
+
+	input := &rds.FailoverGlobalClusterInput{
+		GlobalClusterIdentifier:   aws.String(d.Id()),
+		TargetDbClusterIdentifier: aws.String(targetARN),
+	}
+
+	log.Printf("[DEBUG] Failing over RDS Global Cluster (%s): %s", d.Id(), input)
+	_, err = conn.FailoverGlobalCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("error failing over RDS Global Cluster (%s) to DB Cluster (%s): %w", d.Id(), targetARN, err)
+	}
+
+	if err := waitForGlobalClusterFailover(conn, d.Id(), targetARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for RDS Global Cluster (%s) failover to DB Cluster (%s): %w", d.Id(), targetARN, err)
+	}
+
+	// The members switch roles after the Global Cluster reports the failover as complete.
+	for _, member := range globalCluster.GlobalClusterMembers {
+		memberARN := aws.StringValue(member.DBClusterArn)
+
+		if err := waitForGlobalClusterMemberUpdate(meta, memberARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for RDS Global Cluster (%s) member (%s) update: %w", d.Id(), memberARN, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForGlobalClusterMemberUpdate waits for a DB Cluster that may be in another region.
+func waitForGlobalClusterMemberUpdate(meta interface{}, dbClusterARN string, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	parsedARN, err := arn.Parse(dbClusterARN)
+
+	if err != nil {
+		return fmt.Errorf("error parsing DB Cluster ARN: %w", err)
+	}
+
+	if parsedARN.Region != meta.(*conns.AWSClient).Region {
+		session, err := conns.NewSessionForRegion(&conn.Config, parsedARN.Region, meta.(*conns.AWSClient).TerraformVersion)
+
+		if err != nil {
+			return fmt.Errorf("error creating AWS session: %w", err)
+		}
+
+		conn = rds.New(session)
+	}
+
+	return waitForRDSClusterUpdate(conn, strings.TrimPrefix(parsedARN.Resource, "cluster:"), timeout)
+}
+
+func globalClusterWriterARN(globalCluster *rds.GlobalCluster) string {
+	for _, member := range globalCluster.GlobalClusterMembers {
+		if aws.BoolValue(member.IsWriter) {
+			return aws.StringValue(member.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
 func flattenGlobalClusterMembers(apiObjects []*rds.GlobalClusterMember) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -463,6 +573,52 @@ func waitForGlobalClusterUpdate(conn *rds.RDS, globalClusterID string) error {
 	return err
 }
 
+func rdsGlobalClusterFailoverRefreshFunc(conn *rds.RDS, globalClusterID, targetARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalCluster, err := DescribeGlobalCluster(conn, globalClusterID)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading RDS Global Cluster (%s): %s", globalClusterID, err)
+		}
+
+		if globalCluster == nil {
+			return nil, "deleted", nil
+		}
+
+		if v := globalCluster.FailoverState; v != nil && v.Status != nil {
+			return globalCluster, aws.StringValue(v.Status), nil
+		}
+
+		// The failover is only complete once the target is the writer. The members
+		// are waited for separately.
+		if globalClusterWriterARN(globalCluster) != targetARN {
+			return globalCluster, rds.FailoverStatusPending, nil
+		}
+
+		return globalCluster, aws.StringValue(globalCluster.Status), nil
+	}
+}
+
+func waitForGlobalClusterFailover(conn *rds.RDS, globalClusterID, targetARN string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			rds.FailoverStatusPending,
+			rds.FailoverStatusFailingOver,
+			"modifying",
+		},
+		Target:     []string{"available"},
+		Refresh:    rdsGlobalClusterFailoverRefreshFunc(conn, globalClusterID, targetARN),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for RDS Global Cluster (%s) failover", globalClusterID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
 func WaitForGlobalClusterDeletion(conn *rds.RDS, globalClusterID string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
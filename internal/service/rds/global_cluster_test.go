@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -267,6 +268,69 @@ func TestAccRDSGlobalCluster_engineVersionUpdateMajor(t *testing.T) {
 	})
 }
 
+func TestAccRDSGlobalCluster_primaryClusterIdentifier(t *testing.T) {
+	var providers []*schema.Provider
+	var globalCluster1, globalCluster2, globalCluster3, globalCluster4 rds.GlobalCluster
+	rNameGlobal := sdkacctest.RandomWithPrefix("tf-acc-test-global")
+	rNamePrimary := sdkacctest.RandomWithPrefix("tf-acc-test-primary")
+	rNameSecondary := sdkacctest.RandomWithPrefix("tf-acc-test-secondary")
+	resourceName := "aws_rds_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckGlobalCluster(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			// The Global Cluster is created before the DB Clusters join it, so the
+			// writer can only be asserted once the state has been refreshed.
+			{
+				Config: testAccGlobalClusterPrimaryClusterIdentifierConfig(rNameGlobal, rNamePrimary, rNameSecondary, "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster1),
+				),
+			},
+			{
+				Config: testAccGlobalClusterPrimaryClusterIdentifierConfig(rNameGlobal, rNamePrimary, rNameSecondary, "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster2),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster1, &globalCluster2),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_cluster_identifier", "aws_rds_cluster.primary", "arn"),
+				),
+			},
+			// Switch to the secondary DB Cluster.
+			{
+				Config: testAccGlobalClusterPrimaryClusterIdentifierConfig(rNameGlobal, rNamePrimary, rNameSecondary, "secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster3),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster1, &globalCluster3),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_cluster_identifier", "aws_rds_cluster.secondary", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			// Switch back so that the clusters are destroyed in their original roles.
+			{
+				Config: testAccGlobalClusterPrimaryClusterIdentifierConfig(rNameGlobal, rNamePrimary, rNameSecondary, "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster4),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster1, &globalCluster4),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_cluster_identifier", "aws_rds_cluster.primary", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSGlobalCluster_EngineVersion_auroraMySQL(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -610,6 +674,127 @@ resource "aws_rds_cluster_instance" "test" {
 `, engine, engineVersion, rName)
 }
 
+func testAccGlobalClusterPrimaryClusterIdentifierConfig(rNameGlobal, rNamePrimary, rNameSecondary, primary string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+  state    = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+locals {
+  # The cluster ARNs cannot be referenced directly as the clusters depend on the global cluster.
+  cluster_arns = {
+    primary   = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:cluster:%[2]s"
+    secondary = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.alternate.name}:${data.aws_caller_identity.current.account_id}:cluster:%[3]s"
+  }
+}
+
+resource "aws_rds_global_cluster" "test" {
+  global_cluster_identifier  = %[1]q
+  engine                     = "aurora-mysql"
+  engine_version             = "5.7.mysql_aurora.2.10.2"
+  primary_cluster_identifier = local.cluster_arns[%[4]q]
+  force_destroy              = true
+}
+
+resource "aws_rds_cluster" "primary" {
+  cluster_identifier        = %[2]q
+  database_name             = "mydb"
+  master_username           = "foo"
+  master_password           = "barbarbar"
+  skip_final_snapshot       = true
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+
+  lifecycle {
+    ignore_changes = [
+      replication_source_identifier,
+    ]
+  }
+}
+
+resource "aws_rds_cluster_instance" "primary" {
+  identifier         = %[2]q
+  cluster_identifier = aws_rds_cluster.primary.id
+  instance_class     = "db.r5.large"
+  engine             = aws_rds_cluster.primary.engine
+  engine_version     = aws_rds_cluster.primary.engine_version
+}
+
+resource "aws_vpc" "alternate" {
+  provider   = "awsalternate"
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_subnet" "alternate" {
+  provider          = "awsalternate"
+  count             = 3
+  vpc_id            = aws_vpc.alternate.id
+  availability_zone = data.aws_availability_zones.alternate.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_db_subnet_group" "alternate" {
+  provider   = "awsalternate"
+  name       = %[3]q
+  subnet_ids = aws_subnet.alternate[*].id
+}
+
+resource "aws_rds_cluster" "secondary" {
+  provider                  = "awsalternate"
+  cluster_identifier        = %[3]q
+  db_subnet_group_name      = aws_db_subnet_group.alternate.name
+  skip_final_snapshot       = true
+  source_region             = data.aws_region.current.name
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  depends_on                = [aws_rds_cluster_instance.primary]
+
+  lifecycle {
+    ignore_changes = [
+      replication_source_identifier,
+    ]
+  }
+}
+
+resource "aws_rds_cluster_instance" "secondary" {
+  provider           = "awsalternate"
+  identifier         = %[3]q
+  cluster_identifier = aws_rds_cluster.secondary.id
+  instance_class     = "db.r5.large"
+  engine             = aws_rds_cluster.secondary.engine
+  engine_version     = aws_rds_cluster.secondary.engine_version
+}
+`, rNameGlobal, rNamePrimary, rNameSecondary, primary))
+}
+
 func testAccGlobalClusterSourceClusterIdentifierConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -101,6 +101,12 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"automation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(rds.AutomationMode_Values(), false),
+			},
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -134,6 +140,12 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"custom_iam_instance_profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^AWSRDSCustom.*$`), "must begin with AWSRDSCustom"),
+			},
 			"customer_owned_ip_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -394,6 +406,15 @@ func ResourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"resume_full_automation_mode_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(60, 1440),
+			},
+			"resume_full_automation_mode_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"s3_import": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -563,6 +584,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			requiresModifyDbInstance = true
 		}
 
+		if attr, ok := d.GetOk("custom_iam_instance_profile"); ok {
+			opts.CustomIamInstanceProfile = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
 			opts.DBSubnetGroupName = aws.String(attr.(string))
 		}
@@ -894,6 +919,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			requiresModifyDbInstance = true
 		}
 
+		if attr, ok := d.GetOk("custom_iam_instance_profile"); ok {
+			opts.CustomIamInstanceProfile = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
 			opts.DBSubnetGroupName = aws.String(attr.(string))
 		}
@@ -1054,6 +1083,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 				input.AvailabilityZone = aws.String(v.(string))
 			}
 
+			if v, ok := d.GetOk("custom_iam_instance_profile"); ok {
+				input.CustomIamInstanceProfile = aws.String(v.(string))
+			}
+
 			if v, ok := d.GetOk("domain"); ok {
 				input.Domain = aws.String(v.(string))
 			}
@@ -1175,6 +1208,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			opts.CharacterSetName = aws.String(attr.(string))
 		}
 
+		if attr, ok := d.GetOk("custom_iam_instance_profile"); ok {
+			opts.CustomIamInstanceProfile = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("nchar_character_set_name"); ok {
 			opts.NcharCharacterSetName = aws.String(attr.(string))
 		}
@@ -1308,6 +1345,15 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// RDS Custom automation can only be paused once the DB instance exists.
+	if v, ok := d.GetOk("automation_mode"); ok && v.(string) == rds.AutomationModeAllPaused {
+		modifyDbInstanceInput.AutomationMode = aws.String(v.(string))
+		if v, ok := d.GetOk("resume_full_automation_mode_minutes"); ok {
+			modifyDbInstanceInput.ResumeFullAutomationModeMinutes = aws.Int64(int64(v.(int)))
+		}
+		requiresModifyDbInstance = true
+	}
+
 	d.SetId(d.Get("identifier").(string))
 
 	stateConf := &resource.StateChangeConf{
@@ -1412,6 +1458,13 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("character_set_name", v.CharacterSetName)
 	d.Set("nchar_character_set_name", v.NcharCharacterSetName)
 	d.Set("timezone", v.Timezone)
+	d.Set("automation_mode", v.AutomationMode)
+	d.Set("custom_iam_instance_profile", v.CustomIamInstanceProfile)
+	if v.ResumeFullAutomationModeTime != nil {
+		d.Set("resume_full_automation_mode_time", aws.TimeValue(v.ResumeFullAutomationModeTime).Format(time.RFC3339))
+	} else {
+		d.Set("resume_full_automation_mode_time", nil)
+	}
 
 	dbSetResourceDataEngineVersionFromInstance(d, v)
 
@@ -1747,6 +1800,18 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChanges("automation_mode", "resume_full_automation_mode_minutes") {
+		req.AutomationMode = aws.String(d.Get("automation_mode").(string))
+
+		if d.Get("automation_mode").(string) == rds.AutomationModeAllPaused {
+			if v, ok := d.GetOk("resume_full_automation_mode_minutes"); ok {
+				req.ResumeFullAutomationModeMinutes = aws.Int64(int64(v.(int)))
+			}
+		}
+
+		requestUpdate = true
+	}
+
 	log.Printf("[DEBUG] Send DB Instance Modification request: %t", requestUpdate)
 	if requestUpdate {
 		log.Printf("[DEBUG] DB Instance Modification request: %s", req)
@@ -0,0 +1,194 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInstanceAutomatedBackupsReplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceAutomatedBackupsReplicationCreate,
+		Read:   resourceInstanceAutomatedBackupsReplicationRead,
+		Delete: resourceInstanceAutomatedBackupsReplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(75 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"presigned_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 35),
+			},
+			"source_db_instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceInstanceAutomatedBackupsReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	sourceDBInstanceARN := d.Get("source_db_instance_arn").(string)
+	input := &rds.StartDBInstanceAutomatedBackupsReplicationInput{
+		BackupRetentionPeriod: aws.Int64(int64(d.Get("retention_period").(int))),
+		SourceDBInstanceArn:   aws.String(sourceDBInstanceARN),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("presigned_url"); ok {
+		input.PreSignedUrl = aws.String(v.(string))
+	} else {
+		sourceARN, err := arn.Parse(sourceDBInstanceARN)
+
+		if err != nil {
+			return err
+		}
+
+		// Setting SourceRegion makes the AWS SDK generate the pre-signed URL required to start replication.
+		input.SourceRegion = aws.String(sourceARN.Region)
+	}
+
+	log.Printf("[DEBUG] Starting RDS DB Instance Automated Backups Replication: %s", input)
+	output, err := conn.StartDBInstanceAutomatedBackupsReplication(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting RDS DB Instance (%s) Automated Backups Replication: %w", sourceDBInstanceARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.DBInstanceAutomatedBackup.DBInstanceAutomatedBackupsArn))
+
+	if _, err := waitDBInstanceAutomatedBackupReplicating(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance Automated Backup (%s) replicating: %w", d.Id(), err)
+	}
+
+	return resourceInstanceAutomatedBackupsReplicationRead(d, meta)
+}
+
+func resourceInstanceAutomatedBackupsReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	backup, err := FindDBInstanceAutomatedBackupByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Instance Automated Backup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Instance Automated Backup (%s): %w", d.Id(), err)
+	}
+
+	d.Set("kms_key_id", backup.KmsKeyId)
+	d.Set("retention_period", backup.BackupRetentionPeriod)
+	d.Set("source_db_instance_arn", backup.DBInstanceArn)
+
+	return nil
+}
+
+func resourceInstanceAutomatedBackupsReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	backup, err := FindDBInstanceAutomatedBackupByARN(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Instance Automated Backup (%s): %w", d.Id(), err)
+	}
+
+	dbInstanceID := aws.StringValue(backup.DBInstanceIdentifier)
+	sourceDBInstanceARN := aws.StringValue(backup.DBInstanceArn)
+	sourceARN, err := arn.Parse(sourceDBInstanceARN)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Stopping RDS DB Instance Automated Backups Replication: %s", d.Id())
+	_, err = conn.StopDBInstanceAutomatedBackupsReplication(&rds.StopDBInstanceAutomatedBackupsReplicationInput{
+		SourceDBInstanceArn: aws.String(sourceDBInstanceARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBInstanceStateFault, "not replicating") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping RDS DB Instance (%s) Automated Backups Replication: %w", sourceDBInstanceARN, err)
+	}
+
+	// The replication is tracked on the source DB instance, so poll in its Region.
+	sourceConn, err := instanceAutomatedBackupsReplicationSourceConn(meta, sourceARN.Region)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitDBInstanceAutomatedBackupsReplicationDeleted(sourceConn, dbInstanceID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance Automated Backup (%s) replication stop: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func instanceAutomatedBackupsReplicationSourceConn(meta interface{}, region string) (*rds.RDS, error) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	if region == meta.(*conns.AWSClient).Region {
+		return conn, nil
+	}
+
+	session, err := conns.NewSessionForRegion(&conn.Config, region, meta.(*conns.AWSClient).TerraformVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	return rds.New(session), nil
+}
@@ -0,0 +1,260 @@
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSInstanceAutomatedBackupsReplication_basic(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "7"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_instance_arn", "aws_db_instance.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"presigned_url"},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceAutomatedBackupsReplication_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrds.ResourceInstanceAutomatedBackupsReplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceAutomatedBackupsReplication_retentionPeriod(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationRetentionPeriodConfig(rName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "14"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_instance_arn", "aws_db_instance.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"presigned_url"},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceAutomatedBackupsReplication_kmsEncrypted(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationKMSEncryptedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_instance_arn", "aws_db_instance.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"presigned_url"},
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceAutomatedBackupsReplicationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_instance_automated_backups_replication" {
+			continue
+		}
+
+		output, err := tfrds.FindDBInstanceAutomatedBackupByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Once replication stops, replicated backups are retained until the retention period elapses.
+		if aws.StringValue(output.Status) == tfrds.InstanceAutomatedBackupStatusRetained {
+			continue
+		}
+
+		return fmt.Errorf("RDS DB Instance Automated Backups Replication %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckInstanceAutomatedBackupsReplicationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS DB Instance Automated Backups Replication ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		_, err := tfrds.FindDBInstanceAutomatedBackupByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccInstanceAutomatedBackupsReplicationBaseConfig(rName string, storageEncrypted bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+data "aws_rds_engine_version" "default" {
+  provider = "awsalternate"
+
+  engine = "postgres"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  provider = "awsalternate"
+
+  engine                     = data.aws_rds_engine_version.default.engine
+  engine_version             = data.aws_rds_engine_version.default.version
+  preferred_instance_classes = ["db.t3.micro", "db.t3.small"]
+}
+
+resource "aws_db_instance" "test" {
+  provider = "awsalternate"
+
+  allocated_storage       = 10
+  engine                  = data.aws_rds_engine_version.default.engine
+  engine_version          = data.aws_rds_engine_version.default.version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  name                    = "test"
+  identifier              = %[1]q
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  backup_retention_period = 7
+  storage_encrypted       = %[2]t
+  skip_final_snapshot     = true
+}
+`, rName, storageEncrypted))
+}
+
+func testAccInstanceAutomatedBackupsReplicationConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceAutomatedBackupsReplicationBaseConfig(rName, false),
+		`
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+}
+`)
+}
+
+func testAccInstanceAutomatedBackupsReplicationRetentionPeriodConfig(rName string, retentionPeriod int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceAutomatedBackupsReplicationBaseConfig(rName, false),
+		fmt.Sprintf(`
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+  retention_period       = %[1]d
+}
+`, retentionPeriod))
+}
+
+func testAccInstanceAutomatedBackupsReplicationKMSEncryptedConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceAutomatedBackupsReplicationBaseConfig(rName, true),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+}
+`, rName))
+}
@@ -3566,6 +3566,54 @@ func TestAccRDSInstance_license(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_RDSCustom_automationMode(t *testing.T) {
+	bucket := testAccPreCheckCustomDBEngineVersion(t)
+	var dbInstance1 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	engineVersion := fmt.Sprintf("19.%s", rName)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_RDSCustom_automationMode(rName, bucket, engineVersion, rds.AutomationModeAllPaused, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance1),
+					resource.TestCheckResourceAttr(resourceName, "automation_mode", rds.AutomationModeAllPaused),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_iam_instance_profile", "aws_iam_instance_profile.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "custom-oracle-ee"),
+					resource.TestCheckResourceAttr(resourceName, "resume_full_automation_mode_minutes", "60"),
+					resource.TestCheckResourceAttrSet(resourceName, "resume_full_automation_mode_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"delete_automated_backups",
+					"final_snapshot_identifier",
+					"password",
+					"resume_full_automation_mode_minutes",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_RDSCustom_automationMode(rName, bucket, engineVersion, rds.AutomationModeFull, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance1),
+					resource.TestCheckResourceAttr(resourceName, "automation_mode", rds.AutomationModeFull),
+				),
+			},
+		},
+	})
+}
+
 func testAccInstanceConfig_orderableClass(engine, version, license string) string {
 	return fmt.Sprintf(`
 data "aws_rds_orderable_db_instance" "test" {
@@ -7904,3 +7952,67 @@ resource "aws_db_instance" "test" {
 }
 `, license, rName)
 }
+
+func testAccInstanceConfig_RDSCustom_automationMode(rName, bucket, engineVersion, automationMode string, resumeMinutes int) string {
+	return acctest.ConfigCompose(
+		testAccCustomDBEngineVersionConfig(rName, bucket, engineVersion),
+		acctest.ConfigVpcWithSubnets(2),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonRDSCustomInstanceProfileRolePolicy"
+  role       = aws_iam_role.test.name
+}
+
+# RDS Custom requires the instance profile name to begin with AWSRDSCustom.
+resource "aws_iam_instance_profile" "test" {
+  name = "AWSRDSCustom-%[1]s"
+  role = aws_iam_role.test.name
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage           = 50
+  apply_immediately           = true
+  auto_minor_version_upgrade  = false
+  automation_mode             = %[2]q
+  backup_retention_period     = 7
+  custom_iam_instance_profile = aws_iam_instance_profile.test.name
+  db_subnet_group_name        = aws_db_subnet_group.test.name
+  engine                      = aws_rds_custom_db_engine_version.test.engine
+  engine_version              = aws_rds_custom_db_engine_version.test.engine_version
+  identifier                  = %[1]q
+  instance_class              = "db.m5.large"
+  kms_key_id                  = aws_kms_key.test.arn
+  license_model               = "bring-your-own-license"
+  password                    = "avoid-plaintext-passwords"
+  skip_final_snapshot         = true
+  storage_encrypted           = true
+  username                    = "tfacctest"
+
+  resume_full_automation_mode_minutes = %[3]d
+}
+`, rName, automationMode, resumeMinutes))
+}
@@ -0,0 +1,292 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSnapshotCopyCreate,
+		Read:   resourceSnapshotCopyRead,
+		Update: resourceSnapshotCopyUpdate,
+		Delete: resourceSnapshotCopyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_storage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"db_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"license_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"option_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"presigned_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_custom_availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_db_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][\w-]*$`), "must contain only alphanumeric characters and hyphens"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`-$`), "cannot end in a hyphen"),
+				),
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	sourceDBSnapshotID := d.Get("source_db_snapshot_identifier").(string)
+	targetDBSnapshotID := d.Get("target_db_snapshot_identifier").(string)
+	input := &rds.CopyDBSnapshotInput{
+		SourceDBSnapshotIdentifier: aws.String(sourceDBSnapshotID),
+		Tags:                       Tags(tags.IgnoreAWS()),
+		TargetDBSnapshotIdentifier: aws.String(targetDBSnapshotID),
+	}
+
+	if v, ok := d.GetOk("copy_tags"); ok {
+		input.CopyTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("destination_region"); ok {
+		input.DestinationRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("option_group_name"); ok {
+		input.OptionGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("presigned_url"); ok {
+		input.PreSignedUrl = aws.String(v.(string))
+	} else if sourceARN, err := arn.Parse(sourceDBSnapshotID); err == nil && sourceARN.Region != meta.(*conns.AWSClient).Region {
+		// Setting SourceRegion makes the AWS SDK generate the pre-signed URL required for cross-Region copies.
+		input.SourceRegion = aws.String(sourceARN.Region)
+	}
+
+	if v, ok := d.GetOk("target_custom_availability_zone"); ok {
+		input.TargetCustomAvailabilityZone = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating RDS DB Snapshot Copy: %s", input)
+	output, err := conn.CopyDBSnapshot(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating RDS DB Snapshot Copy (%s): %w", targetDBSnapshotID, err)
+	}
+
+	d.SetId(aws.StringValue(output.DBSnapshot.DBSnapshotIdentifier))
+
+	if _, err := waitDBSnapshotAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Snapshot Copy (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceSnapshotCopyRead(d, meta)
+}
+
+func resourceSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	snapshot, err := FindDBSnapshotByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Snapshot Copy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Snapshot Copy (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(snapshot.DBSnapshotArn)
+	d.Set("allocated_storage", snapshot.AllocatedStorage)
+	d.Set("availability_zone", snapshot.AvailabilityZone)
+	d.Set("db_snapshot_arn", arn)
+	d.Set("encrypted", snapshot.Encrypted)
+	d.Set("engine", snapshot.Engine)
+	d.Set("engine_version", snapshot.EngineVersion)
+	d.Set("iops", snapshot.Iops)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("license_model", snapshot.LicenseModel)
+	d.Set("option_group_name", snapshot.OptionGroupName)
+	d.Set("port", snapshot.Port)
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	// RDS reports the source snapshot as an ARN, so only populate the configured value on import.
+	if _, ok := d.GetOk("source_db_snapshot_identifier"); !ok {
+		d.Set("source_db_snapshot_identifier", snapshot.SourceDBSnapshotIdentifier)
+	}
+	d.Set("source_region", snapshot.SourceRegion)
+	d.Set("storage_type", snapshot.StorageType)
+	d.Set("target_db_snapshot_identifier", snapshot.DBSnapshotIdentifier)
+	d.Set("vpc_id", snapshot.VpcId)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for RDS DB Snapshot Copy (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("db_snapshot_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating RDS DB Snapshot Copy (%s) tags: %w", d.Get("db_snapshot_arn").(string), err)
+		}
+	}
+
+	return resourceSnapshotCopyRead(d, meta)
+}
+
+func resourceSnapshotCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	log.Printf("[DEBUG] Deleting RDS DB Snapshot Copy: %s", d.Id())
+	_, err := conn.DeleteDBSnapshot(&rds.DeleteDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBSnapshotNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RDS DB Snapshot Copy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -79,3 +79,101 @@ func statusDBInstance(conn *rds.RDS, id string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.DBInstanceStatus), nil
 	}
 }
+
+func statusDBSnapshot(conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDBSnapshotByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDBInstanceAutomatedBackup(conn *rds.RDS, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDBInstanceAutomatedBackupByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusDBInstanceAutomatedBackupsReplication reports whether the automated backups
+// replication is still registered against its source DB instance.
+func statusDBInstanceAutomatedBackupsReplication(conn *rds.RDS, dbInstanceID, backupARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDBInstanceAutomatedBackupsReplication(conn, dbInstanceID, backupARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, InstanceAutomatedBackupStatusReplicating, nil
+	}
+}
+
+func statusDBClusterActivityStream(conn *rds.RDS, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDBClusterWithActivityStream(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ActivityStreamStatus), nil
+	}
+}
+
+func statusExportTask(conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindExportTaskByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusCustomDBEngineVersion(conn *rds.RDS, engine, engineVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCustomDBEngineVersion(conn, engine, engineVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
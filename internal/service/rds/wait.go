@@ -1,10 +1,13 @@
 package rds
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -12,6 +15,9 @@ const (
 
 	dbClusterRoleAssociationCreatedTimeout = 5 * time.Minute
 	dbClusterRoleAssociationDeletedTimeout = 5 * time.Minute
+
+	dbClusterActivityStreamStartedTimeout = 30 * time.Minute
+	dbClusterActivityStreamStoppedTimeout = 30 * time.Minute
 )
 
 func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
@@ -198,3 +204,210 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitDBSnapshotAvailable(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBSnapshot, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			DBSnapshotStatusCopying,
+			DBSnapshotStatusCreating,
+			DBSnapshotStatusPending,
+		},
+		Target:     []string{DBSnapshotStatusAvailable},
+		Refresh:    statusDBSnapshot(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceAutomatedBackupReplicating(conn *rds.RDS, arn string, timeout time.Duration) (*rds.DBInstanceAutomatedBackup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{InstanceAutomatedBackupStatusPending},
+		Target:     []string{InstanceAutomatedBackupStatusReplicating},
+		Refresh:    statusDBInstanceAutomatedBackup(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitDBInstanceAutomatedBackupsReplicationDeleted waits for the automated backups replication
+// to be removed from the source DB instance. conn must be for the source DB instance's Region.
+func waitDBInstanceAutomatedBackupsReplicationDeleted(conn *rds.RDS, dbInstanceID, backupARN string, timeout time.Duration) (*rds.DBInstanceAutomatedBackupsReplication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{InstanceAutomatedBackupStatusReplicating},
+		Target:     []string{},
+		Refresh:    statusDBInstanceAutomatedBackupsReplication(conn, dbInstanceID, backupARN),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackupsReplication); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBClusterActivityStreamStarted(conn *rds.RDS, arn string) (*rds.DBCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rds.ActivityStreamStatusStarting},
+		Target:     []string{rds.ActivityStreamStatusStarted},
+		Refresh:    statusDBClusterActivityStream(conn, arn),
+		Timeout:    dbClusterActivityStreamStartedTimeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBClusterActivityStreamStopped(conn *rds.RDS, arn string) (*rds.DBCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rds.ActivityStreamStatusStarted, rds.ActivityStreamStatusStopping},
+		Target:     []string{},
+		Refresh:    statusDBClusterActivityStream(conn, arn),
+		Timeout:    dbClusterActivityStreamStoppedTimeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskCompleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusInProgress, ExportTaskStatusStarting},
+		Target:     []string{ExportTaskStatusComplete},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		if status := aws.StringValue(output.Status); status == ExportTaskStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureCause)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitExportTaskCanceled waits for a canceled export task to stop. The task may still report
+// its previous status right after it is canceled, or complete before the cancel takes effect.
+func waitExportTaskCanceled(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusCanceling, ExportTaskStatusInProgress, ExportTaskStatusStarting},
+		Target:     []string{ExportTaskStatusCanceled, ExportTaskStatusComplete, ExportTaskStatusFailed},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomDBEngineVersionCreated(conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{CustomDBEngineVersionStatusCreating},
+		Target:     []string{CustomDBEngineVersionStatusAvailable, CustomDBEngineVersionStatusPendingValidation},
+		Refresh:    statusCustomDBEngineVersion(conn, engine, engineVersion),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomDBEngineVersionUpdated(conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{CustomDBEngineVersionStatusCreating},
+		Target: []string{
+			CustomDBEngineVersionStatusAvailable,
+			CustomDBEngineVersionStatusInactive,
+			CustomDBEngineVersionStatusInactiveExceptRestore,
+			CustomDBEngineVersionStatusPendingValidation,
+		},
+		Refresh:    statusCustomDBEngineVersion(conn, engine, engineVersion),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomDBEngineVersionDeleted(conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{CustomDBEngineVersionStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusCustomDBEngineVersion(conn, engine, engineVersion),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
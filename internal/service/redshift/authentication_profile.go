@@ -0,0 +1,129 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAuthenticationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuthenticationProfileCreate,
+		Read:   resourceAuthenticationProfileRead,
+		Update: resourceAuthenticationProfileUpdate,
+		Delete: resourceAuthenticationProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authentication_profile_content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"authentication_profile_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+		},
+	}
+}
+
+func resourceAuthenticationProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	name := d.Get("authentication_profile_name").(string)
+	input := &redshift.CreateAuthenticationProfileInput{
+		AuthenticationProfileContent: aws.String(d.Get("authentication_profile_content").(string)),
+		AuthenticationProfileName:    aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Authentication Profile: %s", input)
+	output, err := conn.CreateAuthenticationProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Authentication Profile (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AuthenticationProfileName))
+
+	return resourceAuthenticationProfileRead(d, meta)
+}
+
+func resourceAuthenticationProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	profile, err := FindAuthenticationProfileByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Authentication Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Authentication Profile (%s): %w", d.Id(), err)
+	}
+
+	d.Set("authentication_profile_content", profile.AuthenticationProfileContent)
+	d.Set("authentication_profile_name", profile.AuthenticationProfileName)
+
+	return nil
+}
+
+func resourceAuthenticationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	if d.HasChange("authentication_profile_content") {
+		input := &redshift.ModifyAuthenticationProfileInput{
+			AuthenticationProfileContent: aws.String(d.Get("authentication_profile_content").(string)),
+			AuthenticationProfileName:    aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Redshift Authentication Profile: %s", input)
+		_, err := conn.ModifyAuthenticationProfile(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Redshift Authentication Profile (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAuthenticationProfileRead(d, meta)
+}
+
+func resourceAuthenticationProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	log.Printf("[DEBUG] Deleting Redshift Authentication Profile: %s", d.Id())
+	_, err := conn.DeleteAuthenticationProfile(&redshift.DeleteAuthenticationProfileInput{
+		AuthenticationProfileName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeAuthenticationProfileNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Authentication Profile (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package redshift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftAuthenticationProfile_basic(t *testing.T) {
+	var v redshift.AuthenticationProfile
+	resourceName := "aws_redshift_authentication_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAuthenticationProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthenticationProfileConfig(rName, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthenticationProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authentication_profile_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "authentication_profile_content"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAuthenticationProfileConfig(rName, "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthenticationProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authentication_profile_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "authentication_profile_content"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftAuthenticationProfile_disappears(t *testing.T) {
+	var v redshift.AuthenticationProfile
+	resourceName := "aws_redshift_authentication_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAuthenticationProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthenticationProfileConfig(rName, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthenticationProfileExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceAuthenticationProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuthenticationProfileDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_authentication_profile" {
+			continue
+		}
+
+		_, err := tfredshift.FindAuthenticationProfileByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Authentication Profile %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAuthenticationProfileExists(n string, v *redshift.AuthenticationProfile) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Authentication Profile ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		output, err := tfredshift.FindAuthenticationProfileByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAuthenticationProfileConfig(rName, database string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_authentication_profile" "test" {
+  authentication_profile_name = %[1]q
+  authentication_profile_content = jsonencode(
+    {
+      AllowDBUserOverride = "1"
+      Client_ID           = "ExampleClientID"
+      App_ID              = "example"
+      dbName              = %[2]q
+    }
+  )
+}
+`, rName, database)
+}
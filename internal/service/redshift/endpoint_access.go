@@ -0,0 +1,314 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEndpointAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEndpointAccessCreate,
+		Read:   resourceEndpointAccessRead,
+		Update: resourceEndpointAccessUpdate,
+		Delete: resourceEndpointAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"endpoint_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 30),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][0-9a-z-]*$`), "must begin with a lowercase letter and contain only lowercase alphanumeric characters and hyphens"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`-$`), "cannot end with a hyphen"),
+				),
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"resource_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"subnet_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_endpoint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_interface": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"network_interface_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"private_ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"vpc_endpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceEndpointAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	name := d.Get("endpoint_name").(string)
+	input := &redshift.CreateEndpointAccessInput{
+		ClusterIdentifier: aws.String(d.Get("cluster_identifier").(string)),
+		EndpointName:      aws.String(name),
+		SubnetGroupName:   aws.String(d.Get("subnet_group_name").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_owner"); ok {
+		input.ResourceOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Endpoint Access: %s", input)
+	output, err := conn.CreateEndpointAccess(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Endpoint Access (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.EndpointName))
+
+	if _, err := waitEndpointAccessActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Redshift Endpoint Access (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceEndpointAccessRead(d, meta)
+}
+
+func resourceEndpointAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	endpoint, err := FindEndpointAccessByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Endpoint Access (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Endpoint Access (%s): %w", d.Id(), err)
+	}
+
+	d.Set("address", endpoint.Address)
+	d.Set("cluster_identifier", endpoint.ClusterIdentifier)
+	d.Set("endpoint_name", endpoint.EndpointName)
+	d.Set("port", endpoint.Port)
+	d.Set("resource_owner", endpoint.ResourceOwner)
+	d.Set("subnet_group_name", endpoint.SubnetGroupName)
+
+	if err := d.Set("vpc_endpoint", flattenVpcEndpoint(endpoint.VpcEndpoint)); err != nil {
+		return fmt.Errorf("error setting vpc_endpoint: %w", err)
+	}
+
+	if err := d.Set("vpc_security_group_ids", flattenVpcSecurityGroupIDs(endpoint.VpcSecurityGroups)); err != nil {
+		return fmt.Errorf("error setting vpc_security_group_ids: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEndpointAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	if d.HasChange("vpc_security_group_ids") {
+		input := &redshift.ModifyEndpointAccessInput{
+			EndpointName:        aws.String(d.Id()),
+			VpcSecurityGroupIds: flex.ExpandStringSet(d.Get("vpc_security_group_ids").(*schema.Set)),
+		}
+
+		log.Printf("[DEBUG] Updating Redshift Endpoint Access: %s", input)
+		_, err := conn.ModifyEndpointAccess(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Redshift Endpoint Access (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitEndpointAccessActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Redshift Endpoint Access (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEndpointAccessRead(d, meta)
+}
+
+func resourceEndpointAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	log.Printf("[DEBUG] Deleting Redshift Endpoint Access: %s", d.Id())
+	_, err := conn.DeleteEndpointAccess(&redshift.DeleteEndpointAccessInput{
+		EndpointName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeEndpointNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Endpoint Access (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEndpointAccessDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Redshift Endpoint Access (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenVpcEndpoint(apiObject *redshift.VpcEndpoint) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"network_interface": flattenNetworkInterfaces(apiObject.NetworkInterfaces),
+	}
+
+	if v := apiObject.VpcEndpointId; v != nil {
+		tfMap["vpc_endpoint_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VpcId; v != nil {
+		tfMap["vpc_id"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNetworkInterface(apiObject *redshift.NetworkInterface) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AvailabilityZone; v != nil {
+		tfMap["availability_zone"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.NetworkInterfaceId; v != nil {
+		tfMap["network_interface_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PrivateIpAddress; v != nil {
+		tfMap["private_ip_address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SubnetId; v != nil {
+		tfMap["subnet_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenNetworkInterfaces(apiObjects []*redshift.NetworkInterface) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenNetworkInterface(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenVpcSecurityGroupIDs(apiObjects []*redshift.VpcSecurityGroupMembership) []string {
+	var vpcSecurityGroupIDs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		vpcSecurityGroupIDs = append(vpcSecurityGroupIDs, aws.StringValue(apiObject.VpcSecurityGroupId))
+	}
+
+	return vpcSecurityGroupIDs
+}
@@ -0,0 +1,212 @@
+package redshift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftEndpointAccess_basic(t *testing.T) {
+	var v redshift.EndpointAccess
+	resourceName := "aws_redshift_endpoint_access.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc") // Endpoint names are limited to 30 characters.
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEndpointAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointAccessConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointAccessExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "address"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_identifier", "aws_redshift_cluster.test", "cluster_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "port", "5439"),
+					acctest.CheckResourceAttrAccountID(resourceName, "resource_owner"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_group_name", "aws_redshift_subnet_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_endpoint.0.vpc_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_security_group_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftEndpointAccess_disappears(t *testing.T) {
+	var v redshift.EndpointAccess
+	resourceName := "aws_redshift_endpoint_access.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc") // Endpoint names are limited to 30 characters.
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEndpointAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointAccessConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointAccessExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceEndpointAccess(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftEndpointAccess_vpcSecurityGroupIDs(t *testing.T) {
+	var v redshift.EndpointAccess
+	resourceName := "aws_redshift_endpoint_access.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc") // Endpoint names are limited to 30 characters.
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEndpointAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointAccessConfigSecurityGroup(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointAccessExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "vpc_security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "vpc_security_group_ids.*", "aws_security_group.test.0", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEndpointAccessConfigSecurityGroup(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointAccessExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "vpc_security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "vpc_security_group_ids.*", "aws_security_group.test.1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEndpointAccessDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_endpoint_access" {
+			continue
+		}
+
+		_, err := tfredshift.FindEndpointAccessByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Endpoint Access %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEndpointAccessExists(n string, v *redshift.EndpointAccess) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Endpoint Access ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		output, err := tfredshift.FindEndpointAccessByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccEndpointAccessBaseConfig creates an RA3 cluster, as managed VPC endpoints
+// are only supported for RA3 node types.
+func testAccEndpointAccessBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVpcWithSubnets(2), fmt.Sprintf(`
+resource "aws_redshift_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  cluster_subnet_group_name           = aws_redshift_subnet_group.test.name
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "ra3.xlplus"
+  number_of_nodes                     = 2
+  cluster_type                        = "multi-node"
+  publicly_accessible                 = false
+  automated_snapshot_retention_period = 1
+  encrypted                           = true
+  skip_final_snapshot                 = true
+}
+`, rName))
+}
+
+func testAccEndpointAccessConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEndpointAccessBaseConfig(rName), fmt.Sprintf(`
+resource "aws_redshift_endpoint_access" "test" {
+  endpoint_name      = %[1]q
+  subnet_group_name  = aws_redshift_subnet_group.test.id
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+}
+`, rName))
+}
+
+func testAccEndpointAccessConfigSecurityGroup(rName string, index int) string {
+	return acctest.ConfigCompose(testAccEndpointAccessBaseConfig(rName), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  count = 2
+
+  name   = "%[1]s-${count.index}"
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_redshift_endpoint_access" "test" {
+  endpoint_name          = %[1]q
+  subnet_group_name      = aws_redshift_subnet_group.test.id
+  cluster_identifier     = aws_redshift_cluster.test.cluster_identifier
+  vpc_security_group_ids = [aws_security_group.test[%[2]d].id]
+}
+`, rName, index))
+}
@@ -0,0 +1,207 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEndpointAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEndpointAuthorizationCreate,
+		Read:   resourceEndpointAuthorizationRead,
+		Update: resourceEndpointAuthorizationUpdate,
+		Delete: resourceEndpointAuthorizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"allowed_all_vpcs": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"endpoint_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"grantee": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grantor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceEndpointAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	account := d.Get("account").(string)
+	clusterID := d.Get("cluster_identifier").(string)
+	id := EndpointAuthorizationCreateResourceID(account, clusterID)
+	input := &redshift.AuthorizeEndpointAccessInput{
+		Account:           aws.String(account),
+		ClusterIdentifier: aws.String(clusterID),
+	}
+
+	if v, ok := d.GetOk("vpc_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.VpcIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Endpoint Authorization: %s", input)
+	_, err := conn.AuthorizeEndpointAccess(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Endpoint Authorization (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceEndpointAuthorizationRead(d, meta)
+}
+
+func resourceEndpointAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	account, clusterID, err := EndpointAuthorizationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := FindEndpointAuthorizationByAccountAndClusterID(conn, account, clusterID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Endpoint Authorization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Endpoint Authorization (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account", endpoint.Grantee)
+	d.Set("allowed_all_vpcs", endpoint.AllowedAllVPCs)
+	d.Set("cluster_identifier", endpoint.ClusterIdentifier)
+	d.Set("endpoint_count", endpoint.EndpointCount)
+	d.Set("grantee", endpoint.Grantee)
+	d.Set("grantor", endpoint.Grantor)
+	d.Set("vpc_ids", aws.StringValueSlice(endpoint.AllowedVPCs))
+
+	return nil
+}
+
+func resourceEndpointAuthorizationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	if d.HasChange("vpc_ids") {
+		account, clusterID, err := EndpointAuthorizationParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		o, n := d.GetChange("vpc_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := ns.Difference(os), os.Difference(ns)
+
+		if add.Len() > 0 {
+			input := &redshift.AuthorizeEndpointAccessInput{
+				Account:           aws.String(account),
+				ClusterIdentifier: aws.String(clusterID),
+				VpcIds:            flex.ExpandStringSet(add),
+			}
+
+			log.Printf("[DEBUG] Updating Redshift Endpoint Authorization: %s", input)
+			if _, err := conn.AuthorizeEndpointAccess(input); err != nil {
+				return fmt.Errorf("error authorizing Redshift Endpoint Authorization (%s) VPCs: %w", d.Id(), err)
+			}
+		}
+
+		if del.Len() > 0 {
+			input := &redshift.RevokeEndpointAccessInput{
+				Account:           aws.String(account),
+				ClusterIdentifier: aws.String(clusterID),
+				VpcIds:            flex.ExpandStringSet(del),
+			}
+
+			log.Printf("[DEBUG] Updating Redshift Endpoint Authorization: %s", input)
+			if _, err := conn.RevokeEndpointAccess(input); err != nil {
+				return fmt.Errorf("error revoking Redshift Endpoint Authorization (%s) VPCs: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceEndpointAuthorizationRead(d, meta)
+}
+
+func resourceEndpointAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	account, clusterID, err := EndpointAuthorizationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Endpoint Authorization: %s", d.Id())
+	_, err = conn.RevokeEndpointAccess(&redshift.RevokeEndpointAccessInput{
+		Account:           aws.String(account),
+		ClusterIdentifier: aws.String(clusterID),
+		Force:             aws.Bool(d.Get("force_delete").(bool)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault, redshift.ErrCodeEndpointAuthorizationNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Endpoint Authorization (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEndpointAuthorizationDeleted(conn, account, clusterID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Redshift Endpoint Authorization (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
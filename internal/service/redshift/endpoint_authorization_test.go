@@ -0,0 +1,229 @@
+package redshift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftEndpointAuthorization_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var v redshift.EndpointAuthorization
+	resourceName := "aws_redshift_endpoint_authorization.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckEndpointAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointAuthorizationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointAuthorizationExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "account", "data.aws_caller_identity.test", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_all_vpcs", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_identifier", "aws_redshift_cluster.test", "cluster_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_count", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "grantee", "data.aws_caller_identity.test", "account_id"),
+					acctest.CheckResourceAttrAccountID(resourceName, "grantor"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+			},
+		},
+	})
+}
+
+func TestAccRedshiftEndpointAuthorization_vpcs(t *testing.T) {
+	var providers []*schema.Provider
+	var v redshift.EndpointAuthorization
+	resourceName := "aws_redshift_endpoint_authorization.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckEndpointAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointAuthorizationConfigVPCs(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointAuthorizationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allowed_all_vpcs", "false"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+			},
+			{
+				Config: testAccEndpointAuthorizationConfigVPCs(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointAuthorizationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allowed_all_vpcs", "false"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccEndpointAuthorizationConfigVPCs(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointAuthorizationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allowed_all_vpcs", "false"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftEndpointAuthorization_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var v redshift.EndpointAuthorization
+	resourceName := "aws_redshift_endpoint_authorization.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckEndpointAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointAuthorizationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointAuthorizationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceEndpointAuthorization(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEndpointAuthorizationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_endpoint_authorization" {
+			continue
+		}
+
+		account, clusterID, err := tfredshift.EndpointAuthorizationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfredshift.FindEndpointAuthorizationByAccountAndClusterID(conn, account, clusterID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Endpoint Authorization %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEndpointAuthorizationExists(n string, v *redshift.EndpointAuthorization) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Endpoint Authorization ID is set")
+		}
+
+		account, clusterID, err := tfredshift.EndpointAuthorizationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		output, err := tfredshift.FindEndpointAuthorizationByAccountAndClusterID(conn, account, clusterID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEndpointAuthorizationBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), testAccEndpointAccessBaseConfig(rName), `
+data "aws_caller_identity" "test" {
+  provider = "awsalternate"
+}
+`)
+}
+
+func testAccEndpointAuthorizationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEndpointAuthorizationBaseConfig(rName), `
+resource "aws_redshift_endpoint_authorization" "test" {
+  account            = data.aws_caller_identity.test.account_id
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+}
+`)
+}
+
+func testAccEndpointAuthorizationConfigVPCs(rName string, vpcCount int) string {
+	return acctest.ConfigCompose(testAccEndpointAuthorizationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_vpc" "alternate" {
+  provider = "awsalternate"
+  count    = %[2]d
+
+  cidr_block = "10.${count.index + 1}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_redshift_endpoint_authorization" "test" {
+  account            = data.aws_caller_identity.test.account_id
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+  vpc_ids            = aws_vpc.alternate[*].id
+}
+`, rName, vpcCount))
+}
@@ -37,3 +37,10 @@ func clusterType_Values() []string {
 		clusterTypeSingleNode,
 	}
 }
+
+const (
+	endpointAccessStatusActive    = "active"
+	endpointAccessStatusCreating  = "creating"
+	endpointAccessStatusDeleting  = "deleting"
+	endpointAccessStatusModifying = "modifying"
+)
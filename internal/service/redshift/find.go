@@ -65,3 +65,120 @@ func FindScheduledActionByName(conn *redshift.Redshift, name string) (*redshift.
 
 	return output.ScheduledActions[0], nil
 }
+
+func FindUsageLimitByID(conn *redshift.Redshift, id string) (*redshift.UsageLimit, error) {
+	input := &redshift.DescribeUsageLimitsInput{
+		UsageLimitId: aws.String(id),
+	}
+
+	output, err := conn.DescribeUsageLimits(input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeUsageLimitNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.UsageLimits) == 0 || output.UsageLimits[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.UsageLimits); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.UsageLimits[0], nil
+}
+
+func FindEndpointAccessByName(conn *redshift.Redshift, name string) (*redshift.EndpointAccess, error) {
+	input := &redshift.DescribeEndpointAccessInput{
+		EndpointName: aws.String(name),
+	}
+
+	output, err := conn.DescribeEndpointAccess(input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeEndpointNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.EndpointAccessList) == 0 || output.EndpointAccessList[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.EndpointAccessList); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.EndpointAccessList[0], nil
+}
+
+func FindEndpointAuthorizationByAccountAndClusterID(conn *redshift.Redshift, account, clusterID string) (*redshift.EndpointAuthorization, error) {
+	input := &redshift.DescribeEndpointAuthorizationInput{
+		Account:           aws.String(account),
+		ClusterIdentifier: aws.String(clusterID),
+	}
+
+	output, err := conn.DescribeEndpointAuthorization(input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault, redshift.ErrCodeEndpointAuthorizationNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.EndpointAuthorizationList) == 0 || output.EndpointAuthorizationList[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.EndpointAuthorizationList); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.EndpointAuthorizationList[0], nil
+}
+
+func FindAuthenticationProfileByName(conn *redshift.Redshift, name string) (*redshift.AuthenticationProfile, error) {
+	input := &redshift.DescribeAuthenticationProfilesInput{
+		AuthenticationProfileName: aws.String(name),
+	}
+
+	output, err := conn.DescribeAuthenticationProfiles(input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeAuthenticationProfileNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AuthenticationProfiles) == 0 || output.AuthenticationProfiles[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.AuthenticationProfiles); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.AuthenticationProfiles[0], nil
+}
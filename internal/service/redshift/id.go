@@ -0,0 +1,25 @@
+package redshift
+
+import (
+	"fmt"
+	"strings"
+)
+
+const endpointAuthorizationResourceIDSeparator = ":"
+
+func EndpointAuthorizationCreateResourceID(account, clusterID string) string {
+	parts := []string{account, clusterID}
+	id := strings.Join(parts, endpointAuthorizationResourceIDSeparator)
+
+	return id
+}
+
+func EndpointAuthorizationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, endpointAuthorizationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ACCOUNT%[2]sCLUSTERID", id, endpointAuthorizationResourceIDSeparator)
+}
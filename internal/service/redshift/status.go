@@ -22,3 +22,35 @@ func statusCluster(conn *redshift.Redshift, id string) resource.StateRefreshFunc
 		return output, aws.StringValue(output.ClusterStatus), nil
 	}
 }
+
+func statusEndpointAccess(conn *redshift.Redshift, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEndpointAccessByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.EndpointStatus), nil
+	}
+}
+
+func statusEndpointAuthorization(conn *redshift.Redshift, account, clusterID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEndpointAuthorizationByAccountAndClusterID(conn, account, clusterID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
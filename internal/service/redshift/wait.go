@@ -35,3 +35,57 @@ func waitClusterDeleted(conn *redshift.Redshift, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitEndpointAccessActive(conn *redshift.Redshift, name string, timeout time.Duration) (*redshift.EndpointAccess, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{endpointAccessStatusCreating, endpointAccessStatusModifying},
+		Target:     []string{endpointAccessStatusActive},
+		Refresh:    statusEndpointAccess(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*redshift.EndpointAccess); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointAccessDeleted(conn *redshift.Redshift, name string, timeout time.Duration) (*redshift.EndpointAccess, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{endpointAccessStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusEndpointAccess(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*redshift.EndpointAccess); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointAuthorizationDeleted(conn *redshift.Redshift, account, clusterID string, timeout time.Duration) (*redshift.EndpointAuthorization, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{redshift.AuthorizationStatusAuthorized, redshift.AuthorizationStatusRevoking},
+		Target:     []string{},
+		Refresh:    statusEndpointAuthorization(conn, account, clusterID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*redshift.EndpointAuthorization); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,290 @@
+package redshiftdata
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStatement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStatementCreate,
+		Read:   resourceStatementRead,
+		Update: resourceStatementUpdate,
+		Delete: resourceStatementDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cluster_identifier", "workgroup_name"},
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_user": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"secret_arn"},
+			},
+			"destroy_sql": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameters": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"sqls"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"secret_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sql": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"sql", "sqls"},
+			},
+			"sqls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 40,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"statement_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"with_event": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workgroup_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+		},
+	}
+}
+
+func resourceStatementCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftDataConn
+
+	var id string
+	var err error
+
+	if v, ok := d.GetOk("sqls"); ok && len(v.([]interface{})) > 0 {
+		id, err = batchExecuteStatement(conn, d, flex.ExpandStringList(v.([]interface{})))
+	} else {
+		id, err = executeStatement(conn, d, d.Get("sql").(string), expandParameters(d.Get("parameters").([]interface{})))
+	}
+
+	if err != nil {
+		return fmt.Errorf("error executing Redshift Data Statement: %w", err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitStatementFinished(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Redshift Data Statement (%s) to finish: %w", d.Id(), err)
+	}
+
+	return resourceStatementRead(d, meta)
+}
+
+func resourceStatementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftDataConn
+
+	output, err := FindStatementByID(conn, d.Id())
+
+	// The Data API only keeps statement metadata for 24 hours. The statement has
+	// still been run, so keep it in state rather than running it again.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[DEBUG] Redshift Data Statement (%s) metadata has expired", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Data Statement (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cluster_identifier", output.ClusterIdentifier)
+	d.Set("database", output.Database)
+	d.Set("secret_arn", output.SecretArn)
+	d.Set("workgroup_name", output.WorkgroupName)
+
+	return nil
+}
+
+func resourceStatementUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only destroy_sql can be updated and it is only used on delete.
+	return resourceStatementRead(d, meta)
+}
+
+func resourceStatementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftDataConn
+
+	sql, ok := d.GetOk("destroy_sql")
+
+	if !ok {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Executing Redshift Data Statement (%s) destroy SQL", d.Id())
+	id, err := executeStatement(conn, d, sql.(string), nil)
+
+	if err != nil {
+		return fmt.Errorf("error executing Redshift Data Statement (%s) destroy SQL: %w", d.Id(), err)
+	}
+
+	if _, err := waitStatementFinished(conn, id, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Redshift Data Statement (%s) destroy SQL (%s) to finish: %w", d.Id(), id, err)
+	}
+
+	return nil
+}
+
+func executeStatement(conn *redshiftdataapiservice.RedshiftDataAPIService, d *schema.ResourceData, sql string, parameters []*redshiftdataapiservice.SqlParameter) (string, error) {
+	input := &redshiftdataapiservice.ExecuteStatementInput{
+		Database: aws.String(d.Get("database").(string)),
+		Sql:      aws.String(sql),
+	}
+
+	if v, ok := d.GetOk("cluster_identifier"); ok {
+		input.ClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_user"); ok {
+		input.DbUser = aws.String(v.(string))
+	}
+
+	if len(parameters) > 0 {
+		input.Parameters = parameters
+	}
+
+	if v, ok := d.GetOk("secret_arn"); ok {
+		input.SecretArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("statement_name"); ok {
+		input.StatementName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("with_event"); ok {
+		input.WithEvent = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("workgroup_name"); ok {
+		input.WorkgroupName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Executing Redshift Data Statement: %s", input)
+	output, err := conn.ExecuteStatement(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.Id), nil
+}
+
+func batchExecuteStatement(conn *redshiftdataapiservice.RedshiftDataAPIService, d *schema.ResourceData, sqls []*string) (string, error) {
+	input := &redshiftdataapiservice.BatchExecuteStatementInput{
+		Database: aws.String(d.Get("database").(string)),
+		Sqls:     sqls,
+	}
+
+	if v, ok := d.GetOk("cluster_identifier"); ok {
+		input.ClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_user"); ok {
+		input.DbUser = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("secret_arn"); ok {
+		input.SecretArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("statement_name"); ok {
+		input.StatementName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("with_event"); ok {
+		input.WithEvent = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("workgroup_name"); ok {
+		input.WorkgroupName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Executing Redshift Data Batch Statement: %s", input)
+	output, err := conn.BatchExecuteStatement(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.Id), nil
+}
+
+func expandParameters(tfList []interface{}) []*redshiftdataapiservice.SqlParameter {
+	var apiObjects []*redshiftdataapiservice.SqlParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &redshiftdataapiservice.SqlParameter{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
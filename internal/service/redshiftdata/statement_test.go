@@ -0,0 +1,437 @@
+package redshiftdata_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftdata "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
+)
+
+func TestStatement_localEndpoint(t *testing.T) {
+	api := newLocalRedshiftDataAPI(t)
+	meta := api.meta(t)
+	r := tfredshiftdata.ResourceStatement()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cluster_identifier": "tf-local-test",
+		"database":           "dev",
+		"db_user":            "admin",
+		"destroy_sql":        "DROP SCHEMA example",
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"name":  "owner",
+				"value": "admin",
+			},
+		},
+		"sql":            "CREATE SCHEMA example AUTHORIZATION :owner",
+		"statement_name": "example",
+	})
+
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("error creating statement: %s", err)
+	}
+
+	if d.Id() == "" {
+		t.Fatal("expected statement ID to be set")
+	}
+
+	if got, want := d.Get("cluster_identifier").(string), "tf-local-test"; got != want {
+		t.Errorf("cluster_identifier = %q, want %q", got, want)
+	}
+
+	// Statement metadata expires after 24 hours; the resource must stay in state.
+	api.expire(d.Id())
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("error reading statement: %s", err)
+	}
+
+	if d.Id() == "" {
+		t.Error("expected statement to remain in state after its metadata expired")
+	}
+
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatalf("error deleting statement: %s", err)
+	}
+
+	statements := api.executed()
+
+	if got, want := len(statements), 2; got != want {
+		t.Fatalf("executed %d statements, want %d", got, want)
+	}
+
+	create := statements[0]
+
+	if got, want := create.Sql, "CREATE SCHEMA example AUTHORIZATION :owner"; got != want {
+		t.Errorf("create SQL = %q, want %q", got, want)
+	}
+
+	if got, want := create.DbUser, "admin"; got != want {
+		t.Errorf("create DbUser = %q, want %q", got, want)
+	}
+
+	if got, want := create.StatementName, "example"; got != want {
+		t.Errorf("create StatementName = %q, want %q", got, want)
+	}
+
+	if got, want := len(create.Parameters), 1; got != want {
+		t.Fatalf("create Parameters length = %d, want %d", got, want)
+	}
+
+	if got, want := create.Parameters[0].Name, "owner"; got != want {
+		t.Errorf("create Parameters[0].Name = %q, want %q", got, want)
+	}
+
+	destroy := statements[1]
+
+	if got, want := destroy.Sql, "DROP SCHEMA example"; got != want {
+		t.Errorf("destroy SQL = %q, want %q", got, want)
+	}
+
+	if got, want := len(destroy.Parameters), 0; got != want {
+		t.Errorf("destroy Parameters length = %d, want %d", got, want)
+	}
+}
+
+func TestStatement_localEndpointBatch(t *testing.T) {
+	api := newLocalRedshiftDataAPI(t)
+	meta := api.meta(t)
+	r := tfredshiftdata.ResourceStatement()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"database":   "dev",
+		"secret_arn": "arn:aws:secretsmanager:us-west-2:123456789012:secret:example", //lintignore:AWSAT003,AWSAT005
+		"sqls": []interface{}{
+			"CREATE USER example PASSWORD DISABLE",
+			"GRANT USAGE ON SCHEMA public TO example",
+		},
+		"workgroup_name": "tf-local-test",
+	})
+
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("error creating statement: %s", err)
+	}
+
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatalf("error deleting statement: %s", err)
+	}
+
+	statements := api.executed()
+
+	if got, want := len(statements), 1; got != want {
+		t.Fatalf("executed %d statements, want %d", got, want)
+	}
+
+	if got, want := strings.Join(statements[0].Sqls, ";"), "CREATE USER example PASSWORD DISABLE;GRANT USAGE ON SCHEMA public TO example"; got != want {
+		t.Errorf("Sqls = %q, want %q", got, want)
+	}
+
+	if got, want := statements[0].WorkgroupName, "tf-local-test"; got != want {
+		t.Errorf("WorkgroupName = %q, want %q", got, want)
+	}
+
+	if got, want := d.Get("workgroup_name").(string), "tf-local-test"; got != want {
+		t.Errorf("workgroup_name = %q, want %q", got, want)
+	}
+}
+
+func TestStatement_localEndpointFailed(t *testing.T) {
+	api := newLocalRedshiftDataAPI(t)
+	meta := api.meta(t)
+	r := tfredshiftdata.ResourceStatement()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cluster_identifier": "tf-local-test",
+		"database":           "dev",
+		"db_user":            "admin",
+		"sql":                "CREATE SCHEMA example AUTHORIZATION nobody",
+	})
+
+	api.fail(`ERROR: user "nobody" does not exist`)
+
+	err := r.Create(d, meta)
+
+	if err == nil {
+		t.Fatal("expected error creating statement")
+	}
+
+	if !strings.Contains(err.Error(), `user "nobody" does not exist`) {
+		t.Errorf("expected statement error in %q", err)
+	}
+}
+
+type localStatement struct {
+	ClusterIdentifier string
+	Database          string
+	DbUser            string
+	Parameters        []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	SecretArn     string
+	Sql           string
+	Sqls          []string
+	StatementName string
+	WorkgroupName string
+}
+
+// localRedshiftDataAPI is a minimal in-memory implementation of the Redshift Data API
+// ExecuteStatement, BatchExecuteStatement and DescribeStatement JSON 1.1 operations.
+// Statements finish as soon as they are submitted unless a failure has been queued.
+type localRedshiftDataAPI struct {
+	t *testing.T
+
+	mu         sync.Mutex
+	statements []localStatement
+	status     map[string]map[string]interface{}
+	failure    string
+}
+
+func newLocalRedshiftDataAPI(t *testing.T) *localRedshiftDataAPI {
+	return &localRedshiftDataAPI{
+		t:      t,
+		status: map[string]map[string]interface{}{},
+	}
+}
+
+func (api *localRedshiftDataAPI) meta(t *testing.T) *conns.AWSClient {
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return &conns.AWSClient{RedshiftDataConn: redshiftdataapiservice.New(sess)}
+}
+
+func (api *localRedshiftDataAPI) executed() []localStatement {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return api.statements
+}
+
+func (api *localRedshiftDataAPI) expire(id string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	delete(api.status, id)
+}
+
+func (api *localRedshiftDataAPI) fail(message string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.failure = message
+}
+
+func (api *localRedshiftDataAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	writeJSON := func(status int, v interface{}) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(status)
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			api.t.Errorf("error encoding response: %s", err)
+		}
+	}
+
+	switch target := r.Header.Get("X-Amz-Target"); target {
+	case "RedshiftData.ExecuteStatement", "RedshiftData.BatchExecuteStatement":
+		var input localStatement
+
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			api.t.Errorf("error decoding %s request: %s", target, err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		id := fmt.Sprintf("00000000-0000-0000-0000-%012d", len(api.statements)+1)
+		api.statements = append(api.statements, input)
+
+		statement := map[string]interface{}{
+			"ClusterIdentifier": input.ClusterIdentifier,
+			"Database":          input.Database,
+			"Id":                id,
+			"Status":            redshiftdataapiservice.StatusStringFinished,
+		}
+		if input.SecretArn != "" {
+			statement["SecretArn"] = input.SecretArn
+		}
+		if input.WorkgroupName != "" {
+			statement["WorkgroupName"] = input.WorkgroupName
+		}
+		if api.failure != "" {
+			statement["Error"] = api.failure
+			statement["Status"] = redshiftdataapiservice.StatusStringFailed
+		}
+		api.status[id] = statement
+
+		writeJSON(http.StatusOK, map[string]interface{}{"Id": id})
+
+	case "RedshiftData.DescribeStatement":
+		var input struct {
+			Id string
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			api.t.Errorf("error decoding DescribeStatement request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		statement, ok := api.status[input.Id]
+
+		if !ok {
+			writeJSON(http.StatusBadRequest, map[string]interface{}{
+				"__type":  redshiftdataapiservice.ErrCodeResourceNotFoundException,
+				"Message": fmt.Sprintf("Query does not exist: %s", input.Id),
+			})
+			return
+		}
+
+		writeJSON(http.StatusOK, statement)
+
+	default:
+		api.t.Errorf("unexpected request: %s", target)
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func TestAccRedshiftDataStatement_basic(t *testing.T) {
+	var v redshiftdataapiservice.DescribeStatementOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshiftdata_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshiftdataapiservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatementConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStatementExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_identifier", "aws_redshift_cluster.test", "cluster_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "database", "dev"),
+					resource.TestCheckResourceAttr(resourceName, "db_user", "tfacctest"),
+					resource.TestCheckResourceAttr(resourceName, "sql", "CREATE GROUP tfacctest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataStatement_batch(t *testing.T) {
+	var v redshiftdataapiservice.DescribeStatementOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshiftdata_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshiftdataapiservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatementBatchConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStatementExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destroy_sql", "DROP SCHEMA tfacctest CASCADE"),
+					resource.TestCheckResourceAttr(resourceName, "sqls.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sqls.0", "CREATE SCHEMA tfacctest"),
+					resource.TestCheckResourceAttr(resourceName, "sqls.1", "CREATE TABLE tfacctest.example (id INT)"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStatementExists(n string, v *redshiftdataapiservice.DescribeStatementOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Statement ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftDataConn
+
+		output, err := tfredshiftdata.FindStatementByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccStatementBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  database_name                       = "dev"
+  master_username                     = "tfacctest"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc2.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rName)
+}
+
+func testAccStatementConfig(rName string) string {
+	return acctest.ConfigCompose(testAccStatementBaseConfig(rName), `
+resource "aws_redshiftdata_statement" "test" {
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+  database           = aws_redshift_cluster.test.database_name
+  db_user            = aws_redshift_cluster.test.master_username
+  sql                = "CREATE GROUP tfacctest"
+}
+`)
+}
+
+func testAccStatementBatchConfig(rName string) string {
+	return acctest.ConfigCompose(testAccStatementBaseConfig(rName), `
+resource "aws_redshiftdata_statement" "test" {
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+  database           = aws_redshift_cluster.test.database_name
+  db_user            = aws_redshift_cluster.test.master_username
+  destroy_sql        = "DROP SCHEMA tfacctest CASCADE"
+
+  sqls = [
+    "CREATE SCHEMA tfacctest",
+    "CREATE TABLE tfacctest.example (id INT)",
+  ]
+}
+`)
+}
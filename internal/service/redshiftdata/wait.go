@@ -0,0 +1,37 @@
+package redshiftdata
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Most DDL statements complete within seconds, so poll without an initial delay.
+func waitStatementFinished(conn *redshiftdataapiservice.RedshiftDataAPIService, id string, timeout time.Duration) (*redshiftdataapiservice.DescribeStatementOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			redshiftdataapiservice.StatusStringPicked,
+			redshiftdataapiservice.StatusStringStarted,
+			redshiftdataapiservice.StatusStringSubmitted,
+		},
+		Target:  []string{redshiftdataapiservice.StatusStringFinished},
+		Refresh: statusStatement(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*redshiftdataapiservice.DescribeStatementOutput); ok {
+		if status := aws.StringValue(output.Status); (status == redshiftdataapiservice.StatusStringFailed || status == redshiftdataapiservice.StatusStringAborted) && output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Error)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}